@@ -1 +1,91 @@
 package entity
+
+// UpdateTypeMessage is a constant that indicates an update containing a new incoming message
+const UpdateTypeMessage = "message"
+
+// UpdateTypeEditedMessage is a constant that indicates an update containing an edited message
+const UpdateTypeEditedMessage = "edited_message"
+
+// UpdateTypeChannelPost is a constant that indicates an update containing a new channel post
+const UpdateTypeChannelPost = "channel_post"
+
+// UpdateTypeEditedChannelPost is a constant that indicates an update containing an edited channel post
+const UpdateTypeEditedChannelPost = "edited_channel_post"
+
+// UpdateTypeCallbackQuery is a constant that indicates an update containing a callback query
+const UpdateTypeCallbackQuery = "callback_query"
+
+// UpdateTypeInlineQuery is a constant that indicates an update containing an inline query
+const UpdateTypeInlineQuery = "inline_query"
+
+// UpdateTypeChosenInlineResult is a constant that indicates an update containing a chosen inline query result
+const UpdateTypeChosenInlineResult = "chosen_inline_result"
+
+// UpdateTypeShippingQuery is a constant that indicates an update containing a shipping query of a flexible invoice
+const UpdateTypeShippingQuery = "shipping_query"
+
+// UpdateTypePreCheckoutQuery is a constant that indicates an update containing a pre-checkout query
+const UpdateTypePreCheckoutQuery = "pre_checkout_query"
+
+// UpdateTypeMyChatMember is a constant that indicates an update containing a change in the status of the bot in a chat
+const UpdateTypeMyChatMember = "my_chat_member"
+
+// UpdateTypeChatMember is a constant that indicates an update containing a change in the status of a chat member
+const UpdateTypeChatMember = "chat_member"
+
+// UpdateTypeChatJoinRequest is a constant that indicates an update containing a request to join a chat
+const UpdateTypeChatJoinRequest = "chat_join_request"
+
+// ChatTypePrivate is a constant that indicates a private chat with a user
+const ChatTypePrivate = "private"
+
+// ChatTypeGroup is a constant that indicates a basic group chat
+const ChatTypeGroup = "group"
+
+// ChatTypeSuperGroup is a constant that indicates a supergroup chat
+const ChatTypeSuperGroup = "supergroup"
+
+// ChatTypeChannel is a constant that indicates a channel chat
+const ChatTypeChannel = "channel"
+
+// ChatMemberStatusCreator is a constant that indicates a chat member that owns the chat
+const ChatMemberStatusCreator = "creator"
+
+// ChatMemberStatusAdministrator is a constant that indicates a chat member that is an administrator of the chat
+const ChatMemberStatusAdministrator = "administrator"
+
+// ChatMemberStatusMember is a constant that indicates a chat member without any additional privileges or restrictions
+const ChatMemberStatusMember = "member"
+
+// ChatMemberStatusRestricted is a constant that indicates a chat member that has some restrictions applied
+const ChatMemberStatusRestricted = "restricted"
+
+// ChatMemberStatusLeft is a constant that indicates a user that isn't a member of the chat but can join it
+const ChatMemberStatusLeft = "left"
+
+// ChatMemberStatusKicked is a constant that indicates a user that was banned from the chat and can't join it
+const ChatMemberStatusKicked = "kicked"
+
+// PollTypeRegular is a constant that indicates a regular poll
+const PollTypeRegular = "regular"
+
+// PollTypeQuiz is a constant that indicates a quiz poll, which has exactly one correct option
+const PollTypeQuiz = "quiz"
+
+// DiceEmojiDice is a constant that indicates a dice with values from 1 to 6
+const DiceEmojiDice = "🎲"
+
+// DiceEmojiDarts is a constant that indicates a dart board with values from 1 to 6
+const DiceEmojiDarts = "🎯"
+
+// DiceEmojiBowling is a constant that indicates a bowling ball with values from 1 to 6
+const DiceEmojiBowling = "🎳"
+
+// DiceEmojiBasketball is a constant that indicates a basketball with values from 1 to 5
+const DiceEmojiBasketball = "🏀"
+
+// DiceEmojiFootball is a constant that indicates a football with values from 1 to 5
+const DiceEmojiFootball = "⚽"
+
+// DiceEmojiSlotMachine is a constant that indicates a slot machine with values from 1 to 64
+const DiceEmojiSlotMachine = "🎰"
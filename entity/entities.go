@@ -1,21 +1,24 @@
 package entity
 
+import "encoding/json"
+
 // Update is a Telegram object that the handler receives every time an user interacts with the bot.
 type Update struct {
-	UpdateID          int64         `json:"update_id"`
-	Message           Message       `json:"message"`
-	EditedMessage     Message       `json:"edited_message"`
-	ChannelPost       Message       `json:"channel_post"`
-	EditedChannelPost Message       `json:"edited_channel_post"`
-	CallbackQuery     CallbackQuery `json:"callback_query"`
-	// 	InlineQuery        InlineQuery        `json:"inline_query"`
-	// 	ChosenInlineResult ChosenInlineResult `json:"chosen_inline_result"`
-	// 	ShippingQuery      ShippingQuery      `json:"shipping_query"`
-	// 	PreCheckoutQuery   PreCheckoutQuery   `json:"pre_checkout_query"`
+	UpdateID           int64              `json:"update_id"`
+	Message            Message            `json:"message"`
+	EditedMessage      Message            `json:"edited_message"`
+	ChannelPost        Message            `json:"channel_post"`
+	EditedChannelPost  Message            `json:"edited_channel_post"`
+	CallbackQuery      CallbackQuery      `json:"callback_query"`
+	InlineQuery        InlineQuery        `json:"inline_query"`
+	ChosenInlineResult ChosenInlineResult `json:"chosen_inline_result"`
+	ShippingQuery      ShippingQuery      `json:"shipping_query"`
+	PreCheckoutQuery   PreCheckoutQuery   `json:"pre_checkout_query"`
+	MyChatMember       ChatMemberUpdated  `json:"my_chat_member"`
+	ChatMember         ChatMemberUpdated  `json:"chat_member"`
+	ChatJoinRequest    ChatJoinRequest    `json:"chat_join_request"`
 	// 	Poll               Poll               `json:"poll"`
 	// 	PollAnswer         PollAnswer         `json:"poll_answer"`
-	// 	ChatMemberUpdated  ChatMemberUpdated  `json:"my_chat_member"`
-	// 	ChatMemberUpdated  ChatMemberUpdated  `json:"chat_member"`
 }
 
 // Message is a Telegram object that can be found inside an update.
@@ -55,21 +58,21 @@ type Message struct {
 	CaptionEntities       []*MessageEntity     `json:"caption_entities"`
 	Animation             Animation            `json:"animation"`
 	Video                 Video                `json:"video"`
-	// Audio                         Audio                         `json:"audio"`
-	// Photo                         []*PhotoSize                  `json:"photo"`
+	Photo                 []*PhotoSize         `json:"photo"`
+	NewChatPhoto          []*PhotoSize         `json:"new_chat_photo"`
+	Audio                 Audio                `json:"audio"`
+	Voice                 Voice                `json:"voice"`
+	VideoNote             VideoNote            `json:"video_note"`
+	Location              Location             `json:"location"`
+	Venue                 Venue                `json:"venue"`
+	Dice                  Dice                 `json:"dice"`
+	Poll                  Poll                 `json:"poll"`
+	Invoice               Invoice              `json:"invoice"`
+	SuccessfulPayment     SuccessfulPayment    `json:"successful_payment"`
+	Game                  Game                 `json:"game"`
 	// Sticker                       Sticker                       `json:"sticker"`
-	// VideoNote                     VideoNote                     `json:"video_note"`
-	// Voice                         Voice                         `json:"voice"`
 	// PinnedMessage         		 Message              		   `json:"pinned_message"`
-	// Dice                          Dice                          `json:"dice"`
-	// Game                          Game                          `json:"game"`
-	// Poll                          Poll                          `json:"poll"`
-	// Venue                         Venue                         `json:"venue"`
-	// Location                      Location                      `json:"location"`
-	// new_chat_photo                []*PhotoSize                  `json:"new_chat_photo"`
 	// MessageAutoDeleteTimerChanged MessageAutoDeleteTimerChanged `json:"message_auto_delete_timer_changed"`
-	// Invoice                       Invoice                       `json:"invoice"`
-	// SuccessfulPayment             SuccessfulPayment             `json:"successful_payment"`
 	// PassportData                  PassportData                  `json:"passport_data"`
 	// ProximityAlertTriggered       ProximityAlertTriggered       `json:"proximity_alert_triggered"`
 	// VoiceChatScheduled           VoiceChatScheduled             `json:"voice_chat_scheduled"`
@@ -79,6 +82,11 @@ type Message struct {
 
 }
 
+// MessageID is a Telegram object that represents a unique message identifier
+type MessageID struct {
+	MessageID int64 `json:"message_id"`
+}
+
 // CallbackQuery is a Telegram object that can be found inside an update.
 type CallbackQuery struct {
 	ID              string  `json:"id"`
@@ -90,71 +98,190 @@ type CallbackQuery struct {
 	GameShortName   string  `json:"game_short_name"`
 }
 
+// InlineQuery is a Telegram object that represents an incoming inline query
+/* The offset is the one returned as 'next offset' by the previous answer, empty for the first page */
+type InlineQuery struct {
+	ID       string   `json:"id"`
+	From     User     `json:"from"`
+	Query    string   `json:"query"`
+	Offset   string   `json:"offset"`
+	ChatType string   `json:"chat_type"`
+	Location Location `json:"location"`
+}
+
+// ChosenInlineResult is a Telegram object that represents an inline query result chosen by a user
+/* The inline message id is only available if the result has an inline keyboard attached */
+type ChosenInlineResult struct {
+	ResultID        string   `json:"result_id"`
+	From            User     `json:"from"`
+	Location        Location `json:"location"`
+	InlineMessageID string   `json:"inline_message_id"`
+	Query           string   `json:"query"`
+}
+
 // MessageResponse is a response from a telegram bot after performing certain action like sending or editing message
+/* Editing an inline message returns true instead of the edited message, in that case Result is left empty */
 type MessageResponse struct {
-	Ok          bool    `json:"ok"`
-	Result      Message `json:"result"`
-	ErrorCode   int64   `json:"error_code"`
-	Description string  `json:"description"`
+	Ok          bool               `json:"ok"`
+	Result      Message            `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// MessagesResponse is a response from a telegram bot after performing certain action like sending a media group
+type MessagesResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      []Message          `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// PollResponse is a response from a telegram bot after performing certain action like stopping a poll
+type PollResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      Poll               `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// MessageIDResponse is a response from a telegram bot after performing certain action like copying a message
+type MessageIDResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      MessageID          `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// GameHighScoresResponse is a response from a telegram bot after performing certain action like getting game high scores
+type GameHighScoresResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      []GameHighScore    `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// StringResponse is a response from a telegram bot after performing certain action like creating an invoice link
+type StringResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      string             `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
 }
 
 // ChatResponse is a response from a telegram bot after performing certain action like getting chat
 type ChatResponse struct {
-	Ok          bool   `json:"ok"`
-	Result      Chat   `json:"result"`
-	ErrorCode   int64  `json:"error_code"`
-	Description string `json:"description"`
+	Ok          bool               `json:"ok"`
+	Result      Chat               `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
 }
 
 // ChatMemberResponse is a response from a telegram bot after performing certain action like getting chat member
 type ChatMemberResponse struct {
-	Ok          bool       `json:"ok"`
-	Result      ChatMember `json:"result"`
-	ErrorCode   int64      `json:"error_code"`
-	Description string     `json:"description"`
+	Ok          bool               `json:"ok"`
+	Result      ChatMember         `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
 }
 
 // ChatInviteLinkResponse is a response from a telegram bot after creating or editing chat invite link
 type ChatInviteLinkResponse struct {
-	Ok          bool           `json:"ok"`
-	Result      ChatInviteLink `json:"result"`
-	ErrorCode   int64          `json:"error_code"`
-	Description string         `json:"description"`
+	Ok          bool               `json:"ok"`
+	Result      ChatInviteLink     `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
 }
 
 // ChatDefaultResponse is a response from a telegram bot with no result value
 type ChatDefaultResponse struct {
-	Ok          bool        `json:"ok"`
-	Result      interface{} `json:"result"`
-	ErrorCode   int64       `json:"error_code"`
-	Description string      `json:"description"`
+	Ok          bool               `json:"ok"`
+	Result      interface{}        `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// UpdatesResponse is a response from a telegram bot after requesting incoming updates using long polling
+type UpdatesResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      []Update           `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// WebhookInfoResponse is a response from a telegram bot after requesting the current webhook status
+type WebhookInfoResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      WebhookInfo        `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
 }
 
 // ChatMembersResponse is a response from a telegram bot after performing certain action like getting chat administrators
 type ChatMembersResponse struct {
-	Ok          bool         `json:"ok"`
-	Result      []ChatMember `json:"result"`
-	ErrorCode   int64        `json:"error_code"`
-	Description string       `json:"description"`
+	Ok          bool               `json:"ok"`
+	Result      []ChatMember       `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// FileResponse is a response from a telegram bot after requesting the information needed to download a file
+type FileResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      File               `json:"result"`
+	ErrorCode   int64              `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// WebhookInfo is a Telegram object that describes the current status of a webhook
+type WebhookInfo struct {
+	URL                          string   `json:"url"`
+	HasCustomCertificate         bool     `json:"has_custom_certificate"`
+	PendingUpdateCount           int64    `json:"pending_update_count"`
+	IPAddress                    string   `json:"ip_address"`
+	LastErrorDate                int64    `json:"last_error_date"`
+	LastErrorMessage             string   `json:"last_error_message"`
+	LastSynchronizationErrorDate int64    `json:"last_synchronization_error_date"`
+	MaxConnections               int64    `json:"max_connections"`
+	AllowedUpdates               []string `json:"allowed_updates"`
+}
+
+// ResponseParameters is a Telegram object that describes why a request was unsuccessful
+type ResponseParameters struct {
+	MigrateToChatID int64 `json:"migrate_to_chat_id"`
+	RetryAfter      int64 `json:"retry_after"`
 }
 
 // Chat indicates the conversation to which the message belongs.
 type Chat struct {
-	ID                    int64  `json:"id"`
-	Type                  string `json:"type"`
-	Title                 string `json:"title"`
-	UserName              string `json:"username"`
-	FirstName             string `json:"first_name"`
-	LastName              string `json:"last_name"`
-	Description           string `json:"description"`
-	InviteLink            string `json:"invite_link"`
-	PinnedMessage         string `json:"pinned_message"`
-	SlowModeDelay         int64  `json:"slow_mode_delay"`
-	MessageAutoDeleteTime int64  `json:"message_auto_delete_time"`
-	StickerSetName        string `json:"sticker_set_name"`
-	CanSetStickerSet      bool   `json:"can_set_sticker_set"`
-	LinkedChatID          int64  `json:"linked_chat_id"`
-	// Photo ChatPhoto   `json:"photo"`
+	ID                    int64     `json:"id"`
+	Type                  string    `json:"type"`
+	Title                 string    `json:"title"`
+	UserName              string    `json:"username"`
+	FirstName             string    `json:"first_name"`
+	LastName              string    `json:"last_name"`
+	Description           string    `json:"description"`
+	InviteLink            string    `json:"invite_link"`
+	PinnedMessage         string    `json:"pinned_message"`
+	SlowModeDelay         int64     `json:"slow_mode_delay"`
+	MessageAutoDeleteTime int64     `json:"message_auto_delete_time"`
+	StickerSetName        string    `json:"sticker_set_name"`
+	CanSetStickerSet      bool      `json:"can_set_sticker_set"`
+	LinkedChatID          int64     `json:"linked_chat_id"`
+	Photo                 ChatPhoto `json:"photo"`
 	// Permissions           ChatPermissions `json:"permissions"`
 	// Location ChatLocation   `json:"location"`
 }
@@ -172,34 +299,85 @@ type User struct {
 	SupportsInlineQueries   bool   `json:"supports_inline_queries"`
 }
 
-type ChatMember struct {
-	// ChatMemberOwner
+// ChatMember is an interface implemented by all the 'ChatMember' types, each one representing a status of a chat member
+/* Decoded chat members are pointers to the type matching their status, like *ChatMemberAdministrator */
+type ChatMember interface {
+	MemberStatus() string
+	MemberUser() User
+	IsAdmin() bool
+	CanRestrict() bool
+	IsRestrictedUntil(date int64) bool
+}
+
+// ChatMemberOwner is a Telegram object that represents a chat member that owns the chat
+type ChatMemberOwner struct {
 	Status      string `json:"status"`
 	User        User   `json:"user"`
 	IsAnonymous bool   `json:"is_anonymous"`
-	CustomTitle string `json:"custom_title"`
-
-	// ChatMemberAdministrator
-	CanBeEdited         bool `json:"can_be_edited"`
-	CanMangeChat        bool `json:"can_manage_chat"`
-	CanDeleteMessages   bool `json:"can_delete_messages"`
-	CanManageVoiceChats bool `json:"can_manage_voice_chats"`
-	CanRestrictMembers  bool `json:"can_restrict_members"`
-	CanPromoteMembers   bool `json:"can_promote_members"`
-	CanChangeInfo       bool `json:"can_change_info"`
-	CanInviteUsers      bool `json:"can_invite_users"`
-	CanPostMessages     bool `json:"can_post_messages"`
-	CanEditMessages     bool `json:"can_edit_messages"`
-	CanPinMessages      bool `json:"can_pin_messages"`
-
-	// ChatMemberRestricted
-	IsMember             bool  `json:"is_member"`
-	CanSendMessages      bool  `json:"can_send_messages"`
-	CanSendMediaMessages bool  `json:"can_send_media_messages"`
-	CanSendPolls         bool  `json:"can_send_polls"`
-	CanSendOtherMessages bool  `json:"can_send_other_messages"`
-	CanAddWePagePreviews bool  `json:"can_add_web_page_previews"`
-	UntilDate            int64 `json:"until_date"`
+	CustomTitle string `json:"custom_title,omitempty"`
+}
+
+// ChatMemberAdministrator is a Telegram object that represents a chat member that has some additional privileges
+type ChatMemberAdministrator struct {
+	Status              string `json:"status"`
+	User                User   `json:"user"`
+	CanBeEdited         bool   `json:"can_be_edited"`
+	IsAnonymous         bool   `json:"is_anonymous"`
+	CanManageChat       bool   `json:"can_manage_chat"`
+	CanDeleteMessages   bool   `json:"can_delete_messages"`
+	CanManageVoiceChats bool   `json:"can_manage_voice_chats"`
+	CanRestrictMembers  bool   `json:"can_restrict_members"`
+	CanPromoteMembers   bool   `json:"can_promote_members"`
+	CanChangeInfo       bool   `json:"can_change_info"`
+	CanInviteUsers      bool   `json:"can_invite_users"`
+	CanPostMessages     bool   `json:"can_post_messages,omitempty"` // Channels only
+	CanEditMessages     bool   `json:"can_edit_messages,omitempty"` // Channels only
+	CanPinMessages      bool   `json:"can_pin_messages,omitempty"`  // Groups and supergroups only
+	CustomTitle         string `json:"custom_title,omitempty"`
+}
+
+// ChatMemberMember is a Telegram object that represents a chat member without any additional privileges or restrictions
+type ChatMemberMember struct {
+	Status string `json:"status"`
+	User   User   `json:"user"`
+}
+
+// ChatMemberRestricted is a Telegram object that represents a chat member under certain restrictions in the chat
+/* Restricted users can be outside of the chat, in which case 'IsMember' is false */
+type ChatMemberRestricted struct {
+	Status                string `json:"status"`
+	User                  User   `json:"user"`
+	IsMember              bool   `json:"is_member"`
+	CanChangeInfo         bool   `json:"can_change_info"`
+	CanInviteUsers        bool   `json:"can_invite_users"`
+	CanPinMessages        bool   `json:"can_pin_messages"`
+	CanSendMessages       bool   `json:"can_send_messages"`
+	CanSendMediaMessages  bool   `json:"can_send_media_messages"`
+	CanSendPolls          bool   `json:"can_send_polls"`
+	CanSendOtherMessages  bool   `json:"can_send_other_messages"`
+	CanAddWebPagePreviews bool   `json:"can_add_web_page_previews"`
+	UntilDate             int64  `json:"until_date"` // Zero if the user is restricted forever
+}
+
+// ChatMemberLeft is a Telegram object that represents a user that isn't a member of the chat but can join it
+type ChatMemberLeft struct {
+	Status string `json:"status"`
+	User   User   `json:"user"`
+}
+
+// ChatMemberBanned is a Telegram object that represents a user that was banned from the chat and can't join it
+type ChatMemberBanned struct {
+	Status    string `json:"status"`
+	User      User   `json:"user"`
+	UntilDate int64  `json:"until_date"` // Zero if the user is banned forever
+}
+
+// ChatMemberUnknown is a type that represents a chat member with a status that isn't known by the package
+/* It keeps the raw object, so newly added statuses don't fail the decoding of the whole update */
+type ChatMemberUnknown struct {
+	Status string          `json:"status"`
+	User   User            `json:"user"`
+	Raw    json.RawMessage `json:"-"` // The object as it was sent by telegram
 }
 
 type ChatInviteLink struct {
@@ -214,6 +392,28 @@ type ChatInviteLink struct {
 	PendingJoinRequestCount int64  `json:"pending_join_request_count"`
 }
 
+// ChatMemberUpdated is a Telegram object that represents changes in the status of a chat member
+/* For 'my_chat_member' updates the member is the bot itself, while 'chat_member' updates are only received by */
+/* administrator bots that have 'chat_member' in their allowed updates */
+type ChatMemberUpdated struct {
+	Chat          Chat           `json:"chat"`
+	From          User           `json:"from"`
+	Date          int64          `json:"date"`
+	OldChatMember ChatMember     `json:"old_chat_member"`
+	NewChatMember ChatMember     `json:"new_chat_member"`
+	InviteLink    ChatInviteLink `json:"invite_link"`
+}
+
+// ChatJoinRequest is a Telegram object that represents a join request sent to a chat
+/* It can be answered using ApproveChatJoinRequest or DeclineChatJoinRequest */
+type ChatJoinRequest struct {
+	Chat       Chat           `json:"chat"`
+	From       User           `json:"from"`
+	Date       int64          `json:"date"`
+	Bio        string         `json:"bio"`
+	InviteLink ChatInviteLink `json:"invite_link"`
+}
+
 type ChatPermissions struct {
 	CanSendMessages       bool `json:"can_send_messages"`
 	CanSendMediaMessages  bool `json:"can_send_media_messages"`
@@ -227,38 +427,97 @@ type ChatPermissions struct {
 
 // Document is a Telegram document object
 type Document struct {
-	FileID       string `json:"file_id"`
-	FileUniqueID string `json:"file_unique_id"`
-	FileName     string `json:"file_name"`
-	MIMEType     string `json:"mime_type"`
-	FileSize     int64  `json:"file_size"`
-	// Thumb        PhotoSize `json:"thumb"`
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	FileName     string    `json:"file_name"`
+	MIMEType     string    `json:"mime_type"`
+	FileSize     int64     `json:"file_size"`
+	Thumb        PhotoSize `json:"thumb"`
 }
 
 // Document is a Telegram Video object
 type Video struct {
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	Width        int64     `json:"width"`
+	Height       int64     `json:"height"`
+	Duration     int64     `json:"duration"`
+	FileName     string    `json:"file_name"`
+	MIMEType     string    `json:"mime_type"`
+	FileSize     int64     `json:"file_size"`
+	Thumb        PhotoSize `json:"thumb"`
+}
+
+// Animation is a Telegram animation object
+type Animation struct {
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	Width        int64     `json:"width"`
+	Height       int64     `json:"height"`
+	Duration     int64     `json:"duration"`
+	FileName     string    `json:"file_name"`
+	MIMEType     string    `json:"mime_type"`
+	FileSize     int64     `json:"file_size"`
+	Thumb        PhotoSize `json:"thumb"`
+}
+
+// PhotoSize is a Telegram object that represents one size of a photo or a file / sticker thumbnail
+type PhotoSize struct {
 	FileID       string `json:"file_id"`
 	FileUniqueID string `json:"file_unique_id"`
 	Width        int64  `json:"width"`
 	Height       int64  `json:"height"`
-	Duration     int64  `json:"duration"`
-	FileName     string `json:"file_name"`
-	MIMEType     string `json:"mime_type"`
 	FileSize     int64  `json:"file_size"`
-	// Thumb        PhotoSize `json:"thumb"`
 }
 
-// Animation is a Telegram animation object
-type Animation struct {
+// ChatPhoto is a Telegram object that represents a chat photo
+/* The file ids can be used only for downloading the photo, and only while the photo isn't changed */
+type ChatPhoto struct {
+	SmallFileID       string `json:"small_file_id"`
+	SmallFileUniqueID string `json:"small_file_unique_id"`
+	BigFileID         string `json:"big_file_id"`
+	BigFileUniqueID   string `json:"big_file_unique_id"`
+}
+
+// File is a Telegram object that represents a file ready to be downloaded
+/* The file path is an absolute local path when a local Bot API server is used */
+type File struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	FileSize     int64  `json:"file_size"`
+	FilePath     string `json:"file_path"`
+}
+
+// Audio is a Telegram object that represents an audio file to be treated as music
+type Audio struct {
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	Duration     int64     `json:"duration"`
+	Performer    string    `json:"performer"`
+	Title        string    `json:"title"`
+	FileName     string    `json:"file_name"`
+	MIMEType     string    `json:"mime_type"`
+	FileSize     int64     `json:"file_size"`
+	Thumb        PhotoSize `json:"thumb"`
+}
+
+// Voice is a Telegram object that represents a voice note
+type Voice struct {
 	FileID       string `json:"file_id"`
 	FileUniqueID string `json:"file_unique_id"`
-	Width        int64  `json:"width"`
-	Height       int64  `json:"height"`
 	Duration     int64  `json:"duration"`
-	FileName     string `json:"file_name"`
 	MIMEType     string `json:"mime_type"`
 	FileSize     int64  `json:"file_size"`
-	// Thumb        PhotoSize `json:"thumb"`
+}
+
+// VideoNote is a Telegram object that represents a rounded square video message
+type VideoNote struct {
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	Length       int64     `json:"length"`
+	Duration     int64     `json:"duration"`
+	Thumb        PhotoSize `json:"thumb"`
+	FileSize     int64     `json:"file_size"`
 }
 
 // Contact is a Telegram contact object
@@ -270,6 +529,151 @@ type Contact struct {
 	VCard       string `json:"vcard"`
 }
 
+// Location is a Telegram object that represents a point on the map
+type Location struct {
+	Longitude            float64 `json:"longitude"`
+	Latitude             float64 `json:"latitude"`
+	HorizontalAccuracy   float64 `json:"horizontal_accuracy"`
+	LivePeriod           int64   `json:"live_period"`
+	Heading              int64   `json:"heading"`
+	ProximityAlertRadius int64   `json:"proximity_alert_radius"`
+}
+
+// Venue is a Telegram object that represents a venue
+type Venue struct {
+	Location        Location `json:"location"`
+	Title           string   `json:"title"`
+	Address         string   `json:"address"`
+	FoursquareID    string   `json:"foursquare_id"`
+	FoursquareType  string   `json:"foursquare_type"`
+	GooglePlaceID   string   `json:"google_place_id"`
+	GooglePlaceType string   `json:"google_place_type"`
+}
+
+// Dice is a Telegram object that represents an animated emoji that displays a random value
+type Dice struct {
+	Emoji string `json:"emoji"`
+	Value int64  `json:"value"`
+}
+
+// Poll is a Telegram object that contains information about a poll
+type Poll struct {
+	ID                    string           `json:"id"`
+	Question              string           `json:"question"`
+	Options               []*PollOption    `json:"options"`
+	TotalVoterCount       int64            `json:"total_voter_count"`
+	IsClosed              bool             `json:"is_closed"`
+	IsAnonymous           bool             `json:"is_anonymous"`
+	Type                  string           `json:"type"`
+	AllowsMultipleAnswers bool             `json:"allows_multiple_answers"`
+	CorrectOptionID       int64            `json:"correct_option_id"`
+	Explanation           string           `json:"explanation"`
+	ExplanationEntities   []*MessageEntity `json:"explanation_entities"`
+	OpenPeriod            int64            `json:"open_period"`
+	CloseDate             int64            `json:"close_date"`
+}
+
+// PollOption is a Telegram object that contains information about one answer option in a poll
+type PollOption struct {
+	Text       string `json:"text"`
+	VoterCount int64  `json:"voter_count"`
+}
+
+// Game is a Telegram object that represents a game
+/* The text and the text entities are only available for game messages whose text was changed using SetGameScore */
+type Game struct {
+	Title        string           `json:"title"`
+	Description  string           `json:"description"`
+	Photo        []*PhotoSize     `json:"photo"`
+	Text         string           `json:"text"`
+	TextEntities []*MessageEntity `json:"text_entities"`
+	Animation    Animation        `json:"animation"`
+}
+
+// GameHighScore is a Telegram object that represents one row of the high scores table of a game
+type GameHighScore struct {
+	Position int64 `json:"position"`
+	User     User  `json:"user"`
+	Score    int64 `json:"score"`
+}
+
+// CallbackGame is a placeholder that marks an inline keyboard button as the button launching a game
+/* It doesn't hold any information, use &CallbackGame{} for the first button of the first row of a game message */
+type CallbackGame struct{}
+
+// LabeledPrice is a type that represents a portion of the price for goods or services
+/* The amount is in the smallest units of the currency, for example 145 for US$ 1.45 */
+type LabeledPrice struct {
+	Label  string `json:"label"`
+	Amount int64  `json:"amount"`
+}
+
+// Invoice is a Telegram object that contains basic information about an invoice
+type Invoice struct {
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	StartParameter string `json:"start_parameter"`
+	Currency       string `json:"currency"`
+	TotalAmount    int64  `json:"total_amount"`
+}
+
+// ShippingAddress is a Telegram object that represents a shipping address
+type ShippingAddress struct {
+	CountryCode string `json:"country_code"`
+	State       string `json:"state"`
+	City        string `json:"city"`
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2"`
+	PostCode    string `json:"post_code"`
+}
+
+// OrderInfo is a Telegram object that represents information about an order
+type OrderInfo struct {
+	Name            string          `json:"name"`
+	PhoneNumber     string          `json:"phone_number"`
+	Email           string          `json:"email"`
+	ShippingAddress ShippingAddress `json:"shipping_address"`
+}
+
+// ShippingOption is a type that represents one shipping option
+type ShippingOption struct {
+	ID     string          `json:"id"`
+	Title  string          `json:"title"`
+	Prices []*LabeledPrice `json:"prices"`
+}
+
+// SuccessfulPayment is a Telegram object that contains basic information about a successful payment
+type SuccessfulPayment struct {
+	Currency                string    `json:"currency"`
+	TotalAmount             int64     `json:"total_amount"`
+	InvoicePayload          string    `json:"invoice_payload"`
+	ShippingOptionID        string    `json:"shipping_option_id"`
+	OrderInfo               OrderInfo `json:"order_info"`
+	TelegramPaymentChargeID string    `json:"telegram_payment_charge_id"`
+	ProviderPaymentChargeID string    `json:"provider_payment_charge_id"`
+}
+
+// ShippingQuery is a Telegram object that contains information about an incoming shipping query
+/* It is only received for flexible invoices, and it should be answered using AnswerToTelegramShippingQuery */
+type ShippingQuery struct {
+	ID              string          `json:"id"`
+	From            User            `json:"from"`
+	InvoicePayload  string          `json:"invoice_payload"`
+	ShippingAddress ShippingAddress `json:"shipping_address"`
+}
+
+// PreCheckoutQuery is a Telegram object that contains information about an incoming pre-checkout query
+/* It should be answered within 10 seconds, otherwise the payment is cancelled */
+type PreCheckoutQuery struct {
+	ID               string    `json:"id"`
+	From             User      `json:"from"`
+	Currency         string    `json:"currency"`
+	TotalAmount      int64     `json:"total_amount"`
+	InvoicePayload   string    `json:"invoice_payload"`
+	ShippingOptionID string    `json:"shipping_option_id"`
+	OrderInfo        OrderInfo `json:"order_info"`
+}
+
 // MessageEntity is a type that represents one special entity in a text message
 type MessageEntity struct {
 	Type     string `json:"type"`
@@ -283,17 +687,18 @@ type MessageEntity struct {
 // InputMediaPhoto is a type that represents a photo to be sent
 type InputMediaPhoto struct {
 	Type            string           `json:"type"`
-	Media           string           `json:"media"`
+	Media           interface{}      `json:"media"` // It can be string (file id or url) or *InputFile
 	Caption         string           `json:"caption"`
 	ParseMode       string           `json:"parse_mode"`
 	CaptionEntities []*MessageEntity `json:"caption_entities"`
+	HasSpoiler      bool             `json:"has_spoiler"`
 }
 
 // InputMediaVideo is a type that represents a video to be sent
 type InputMediaVideo struct {
 	Type            string           `json:"type"`
-	Media           string           `json:"media"`
-	Thumb           string           `json:"thumb"`
+	Media           interface{}      `json:"media"` // It can be string (file id or url) or *InputFile
+	Thumb           interface{}      `json:"thumb"` // It can be string (file id or url) or *InputFile
 	Caption         string           `json:"caption"`
 	ParseMode       string           `json:"parse_mode"`
 	CaptionEntities []*MessageEntity `json:"caption_entities"`
@@ -306,8 +711,8 @@ type InputMediaVideo struct {
 // InputMediaAnimation is a type that represents a animation to be sent
 type InputMediaAnimation struct {
 	Type            string           `json:"type"`
-	Media           string           `json:"media"`
-	Thumb           string           `json:"thumb"`
+	Media           interface{}      `json:"media"` // It can be string (file id or url) or *InputFile
+	Thumb           interface{}      `json:"thumb"` // It can be string (file id or url) or *InputFile
 	Caption         string           `json:"caption"`
 	ParseMode       string           `json:"parse_mode"`
 	CaptionEntities []*MessageEntity `json:"caption_entities"`
@@ -319,8 +724,8 @@ type InputMediaAnimation struct {
 // InputMediaAudio is a type that represents a audio to be sent
 type InputMediaAudio struct {
 	Type            string           `json:"type"`
-	Media           string           `json:"media"`
-	Thumb           string           `json:"thumb"`
+	Media           interface{}      `json:"media"` // It can be string (file id or url) or *InputFile
+	Thumb           interface{}      `json:"thumb"` // It can be string (file id or url) or *InputFile
 	Caption         string           `json:"caption"`
 	ParseMode       string           `json:"parse_mode"`
 	CaptionEntities []*MessageEntity `json:"caption_entities"`
@@ -332,8 +737,8 @@ type InputMediaAudio struct {
 // InputMediaDocument is a type that represents a general file to be sent
 type InputMediaDocument struct {
 	Type                        string           `json:"type"`
-	Media                       string           `json:"media"`
-	Thumb                       string           `json:"thumb"`
+	Media                       interface{}      `json:"media"` // It can be string (file id or url) or *InputFile
+	Thumb                       interface{}      `json:"thumb"` // It can be string (file id or url) or *InputFile
 	Caption                     string           `json:"caption"`
 	ParseMode                   string           `json:"parse_mode"`
 	CaptionEntities             []*MessageEntity `json:"caption_entities"`
@@ -364,10 +769,14 @@ type Optional struct {
 	Width                       int64
 	Height                      int64
 	ProtectContent              bool
-	Thumb                       string // Only string thumb supported
+	HasSpoiler                  bool
+	Thumb                       interface{} // It can be string (file id or url) or *InputFile, uploaded thumbs are sent using attach://
 	Caption                     string
 	CaptionEntities             []*MessageEntity
 	DisableContentTypeDetection bool
+	Performer                   string
+	Title                       string
+	Length                      int64
 
 	// Invite link optional valus
 	Name              string
@@ -375,12 +784,81 @@ type Optional struct {
 	MemberLimit       int64
 	CreateJoinRequest bool
 
+	// Get updates optional values
+	Offset         int64
+	Limit          int64
+	Timeout        int64
+	AllowedUpdates []string
+
+	// Webhook optional values
+	IPAddress          string
+	MaxConnections     int64
+	DropPendingUpdates bool
+	SecretToken        string
+
+	// Location and venue optional values
+	HorizontalAccuracy   float64
+	LivePeriod           int64
+	Heading              int64
+	ProximityAlertRadius int64
+	FoursquareID         string
+	FoursquareType       string
+	GooglePlaceID        string
+	GooglePlaceType      string
+
+	// Contact optional values
+	LastName string
+	VCard    string
+
+	// Dice optional values
+	Emoji string
+
+	// Inline query optional values
+	IsPersonal        bool
+	NextOffset        string
+	SwitchPMText      string
+	SwitchPMParameter string
+
+	// Poll optional values
+	NonAnonymous          bool // Polls are anonymous by default
+	PollType              string
+	AllowsMultipleAnswers bool
+	CorrectOptionID       int64
+	Explanation           string
+	ExplanationParseMode  string
+	ExplanationEntities   []*MessageEntity
+	OpenPeriod            int64
+	CloseDate             int64
+	IsClosed              bool
+
+	// Payment optional values
+	MaxTipAmount              int64
+	SuggestedTipAmounts       []int64
+	StartParameter            string
+	ProviderData              string
+	PhotoURL                  string
+	PhotoSize                 int64
+	PhotoWidth                int64
+	PhotoHeight               int64
+	NeedName                  bool
+	NeedPhoneNumber           bool
+	NeedEmail                 bool
+	NeedShippingAddress       bool
+	SendPhoneNumberToProvider bool
+	SendEmailToProvider       bool
+	IsFlexible                bool
+	ErrorMessage              string
+
+	// Game optional values
+	Force              bool
+	DisableEditMessage bool
+
 	// Chat member administration
 	UntilDate           int64
 	RevokeMessages      bool
 	OnlyIfBanned        bool
 	IsAnonymous         bool
-	CanMangeChat        bool
+	CanManageChat       bool
 	CanPostMessages     bool
 	CanEditMessages     bool
 	CanDeleteMessages   bool
@@ -429,12 +907,12 @@ type InlineKeyboardMarkup struct {
 
 // InlineKeyboardButton is a struct that represents a Telegram inline keyboard button
 type InlineKeyboardButton struct {
-	Text                         string `json:"text"`
-	URL                          string `json:"url"`
-	CallbackData                 string `json:"callback_data"`
-	SwitchInlineQuery            string `json:"switch_inline_query"`
-	SwitchInlineQueryCurrentChat string `json:"switch_inline_query_current_chat"`
-	Pay                          bool   `json:"pay"`
+	Text                         string        `json:"text"`
+	URL                          string        `json:"url"`
+	CallbackData                 string        `json:"callback_data"`
+	SwitchInlineQuery            string        `json:"switch_inline_query"`
+	SwitchInlineQueryCurrentChat string        `json:"switch_inline_query_current_chat"`
+	Pay                          bool          `json:"pay"`
+	CallbackGame                 *CallbackGame `json:"callback_game,omitempty"` // Only set for the button launching the game
 	// LoginURL                     LoginURL     `json:"login_url"`
-	// CallbackGame                 CallbackGame `json:"callback_game"`
 }
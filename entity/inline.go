@@ -0,0 +1,505 @@
+package entity
+
+import "encoding/json"
+
+// InlineQueryResult is an interface implemented by all the 'InlineQueryResult' types
+/* The type of a result is set automatically when it is empty, so only the 'ID' and the required fields should be set */
+type InlineQueryResult interface {
+	inlineQueryResult()
+}
+
+// InputMessageContent is an interface implemented by all the 'Input...MessageContent' types
+/* It represents the content of a message to be sent as a result of an inline query */
+type InputMessageContent interface {
+	inputMessageContent()
+}
+
+// InlineQueryResultArticle is a type that represents a link to an article or web page
+type InlineQueryResultArticle struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	Title               string                `json:"title"`
+	InputMessageContent InputMessageContent   `json:"input_message_content"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	URL                 string                `json:"url,omitempty"`
+	HideURL             bool                  `json:"hide_url,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	ThumbURL            string                `json:"thumb_url,omitempty"`
+	ThumbWidth          int64                 `json:"thumb_width,omitempty"`
+	ThumbHeight         int64                 `json:"thumb_height,omitempty"`
+}
+
+// InlineQueryResultPhoto is a type that represents a link to a photo, by default it is sent with an optional caption
+type InlineQueryResultPhoto struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	PhotoURL            string                `json:"photo_url"`
+	ThumbURL            string                `json:"thumb_url"`
+	PhotoWidth          int64                 `json:"photo_width,omitempty"`
+	PhotoHeight         int64                 `json:"photo_height,omitempty"`
+	Title               string                `json:"title,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultGif is a type that represents a link to an animated GIF file, by default it is sent with an optional caption
+type InlineQueryResultGif struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	GifURL              string                `json:"gif_url"`
+	GifWidth            int64                 `json:"gif_width,omitempty"`
+	GifHeight           int64                 `json:"gif_height,omitempty"`
+	GifDuration         int64                 `json:"gif_duration,omitempty"`
+	ThumbURL            string                `json:"thumb_url"`
+	ThumbMIMEType       string                `json:"thumb_mime_type,omitempty"`
+	Title               string                `json:"title,omitempty"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultVideo is a type that represents a link to a page containing an embedded video player or a video file
+type InlineQueryResultVideo struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	VideoURL            string                `json:"video_url"`
+	MIMEType            string                `json:"mime_type"`
+	ThumbURL            string                `json:"thumb_url"`
+	Title               string                `json:"title"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	VideoWidth          int64                 `json:"video_width,omitempty"`
+	VideoHeight         int64                 `json:"video_height,omitempty"`
+	VideoDuration       int64                 `json:"video_duration,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultAudio is a type that represents a link to an MP3 audio file
+type InlineQueryResultAudio struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	AudioURL            string                `json:"audio_url"`
+	Title               string                `json:"title"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	Performer           string                `json:"performer,omitempty"`
+	AudioDuration       int64                 `json:"audio_duration,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultDocument is a type that represents a link to a PDF or ZIP file, by default it is sent with an optional caption
+type InlineQueryResultDocument struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	Title               string                `json:"title"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	DocumentURL         string                `json:"document_url"`
+	MIMEType            string                `json:"mime_type"`
+	Description         string                `json:"description,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	ThumbURL            string                `json:"thumb_url,omitempty"`
+	ThumbWidth          int64                 `json:"thumb_width,omitempty"`
+	ThumbHeight         int64                 `json:"thumb_height,omitempty"`
+}
+
+// InlineQueryResultLocation is a type that represents a location on a map
+type InlineQueryResultLocation struct {
+	Type                 string                `json:"type"`
+	ID                   string                `json:"id"`
+	Latitude             float64               `json:"latitude"`
+	Longitude            float64               `json:"longitude"`
+	Title                string                `json:"title"`
+	HorizontalAccuracy   float64               `json:"horizontal_accuracy,omitempty"`
+	LivePeriod           int64                 `json:"live_period,omitempty"`
+	Heading              int64                 `json:"heading,omitempty"`
+	ProximityAlertRadius int64                 `json:"proximity_alert_radius,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent  InputMessageContent   `json:"input_message_content,omitempty"`
+	ThumbURL             string                `json:"thumb_url,omitempty"`
+	ThumbWidth           int64                 `json:"thumb_width,omitempty"`
+	ThumbHeight          int64                 `json:"thumb_height,omitempty"`
+}
+
+// InlineQueryResultVenue is a type that represents a venue
+type InlineQueryResultVenue struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	Latitude            float64               `json:"latitude"`
+	Longitude           float64               `json:"longitude"`
+	Title               string                `json:"title"`
+	Address             string                `json:"address"`
+	FoursquareID        string                `json:"foursquare_id,omitempty"`
+	FoursquareType      string                `json:"foursquare_type,omitempty"`
+	GooglePlaceID       string                `json:"google_place_id,omitempty"`
+	GooglePlaceType     string                `json:"google_place_type,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	ThumbURL            string                `json:"thumb_url,omitempty"`
+	ThumbWidth          int64                 `json:"thumb_width,omitempty"`
+	ThumbHeight         int64                 `json:"thumb_height,omitempty"`
+}
+
+// InlineQueryResultContact is a type that represents a contact with a phone number
+type InlineQueryResultContact struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	PhoneNumber         string                `json:"phone_number"`
+	FirstName           string                `json:"first_name"`
+	LastName            string                `json:"last_name,omitempty"`
+	VCard               string                `json:"vcard,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	ThumbURL            string                `json:"thumb_url,omitempty"`
+	ThumbWidth          int64                 `json:"thumb_width,omitempty"`
+	ThumbHeight         int64                 `json:"thumb_height,omitempty"`
+}
+
+// InlineQueryResultCachedPhoto is a type that represents a link to a photo stored on telegram servers
+type InlineQueryResultCachedPhoto struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	PhotoFileID         string                `json:"photo_file_id"`
+	Title               string                `json:"title,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedGif is a type that represents a link to an animated GIF file stored on telegram servers
+type InlineQueryResultCachedGif struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	GifFileID           string                `json:"gif_file_id"`
+	Title               string                `json:"title,omitempty"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedSticker is a type that represents a link to a sticker stored on telegram servers
+type InlineQueryResultCachedSticker struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	StickerFileID       string                `json:"sticker_file_id"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedDocument is a type that represents a link to a file stored on telegram servers
+type InlineQueryResultCachedDocument struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	Title               string                `json:"title"`
+	DocumentFileID      string                `json:"document_file_id"`
+	Description         string                `json:"description,omitempty"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedVideo is a type that represents a link to a video file stored on telegram servers
+type InlineQueryResultCachedVideo struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	VideoFileID         string                `json:"video_file_id"`
+	Title               string                `json:"title"`
+	Description         string                `json:"description,omitempty"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedVoice is a type that represents a link to a voice message stored on telegram servers
+type InlineQueryResultCachedVoice struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	VoiceFileID         string                `json:"voice_file_id"`
+	Title               string                `json:"title"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedAudio is a type that represents a link to an MP3 audio file stored on telegram servers
+type InlineQueryResultCachedAudio struct {
+	Type                string                `json:"type"`
+	ID                  string                `json:"id"`
+	AudioFileID         string                `json:"audio_file_id"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           string                `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultGame is a type that represents a game
+type InlineQueryResultGame struct {
+	Type          string                `json:"type"`
+	ID            string                `json:"id"`
+	GameShortName string                `json:"game_short_name"`
+	ReplyMarkup   *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultArticle, setting its type if it is empty
+func (result InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultArticle
+	if result.Type == "" {
+		result.Type = "article"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultPhoto, setting its type if it is empty
+func (result InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultPhoto
+	if result.Type == "" {
+		result.Type = "photo"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultGif, setting its type if it is empty
+func (result InlineQueryResultGif) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultGif
+	if result.Type == "" {
+		result.Type = "gif"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultVideo, setting its type if it is empty
+func (result InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultVideo
+	if result.Type == "" {
+		result.Type = "video"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultAudio, setting its type if it is empty
+func (result InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultAudio
+	if result.Type == "" {
+		result.Type = "audio"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultDocument, setting its type if it is empty
+func (result InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultDocument
+	if result.Type == "" {
+		result.Type = "document"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultLocation, setting its type if it is empty
+func (result InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultLocation
+	if result.Type == "" {
+		result.Type = "location"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultVenue, setting its type if it is empty
+func (result InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultVenue
+	if result.Type == "" {
+		result.Type = "venue"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultContact, setting its type if it is empty
+func (result InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultContact
+	if result.Type == "" {
+		result.Type = "contact"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultCachedPhoto, setting its type if it is empty
+func (result InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultCachedPhoto
+	if result.Type == "" {
+		result.Type = "photo"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultCachedGif, setting its type if it is empty
+func (result InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultCachedGif
+	if result.Type == "" {
+		result.Type = "gif"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultCachedSticker, setting its type if it is empty
+func (result InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultCachedSticker
+	if result.Type == "" {
+		result.Type = "sticker"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultCachedDocument, setting its type if it is empty
+func (result InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultCachedDocument
+	if result.Type == "" {
+		result.Type = "document"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultCachedVideo, setting its type if it is empty
+func (result InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultCachedVideo
+	if result.Type == "" {
+		result.Type = "video"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultCachedVoice, setting its type if it is empty
+func (result InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultCachedVoice
+	if result.Type == "" {
+		result.Type = "voice"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultCachedAudio, setting its type if it is empty
+func (result InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultCachedAudio
+	if result.Type == "" {
+		result.Type = "audio"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+// MarshalJSON is a method that encodes the InlineQueryResultGame, setting its type if it is empty
+func (result InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	type inlineQueryResult InlineQueryResultGame
+	if result.Type == "" {
+		result.Type = "game"
+	}
+	return json.Marshal(inlineQueryResult(result))
+}
+
+func (InlineQueryResultArticle) inlineQueryResult()        {}
+func (InlineQueryResultPhoto) inlineQueryResult()          {}
+func (InlineQueryResultGif) inlineQueryResult()            {}
+func (InlineQueryResultVideo) inlineQueryResult()          {}
+func (InlineQueryResultAudio) inlineQueryResult()          {}
+func (InlineQueryResultDocument) inlineQueryResult()       {}
+func (InlineQueryResultLocation) inlineQueryResult()       {}
+func (InlineQueryResultVenue) inlineQueryResult()          {}
+func (InlineQueryResultContact) inlineQueryResult()        {}
+func (InlineQueryResultCachedPhoto) inlineQueryResult()    {}
+func (InlineQueryResultCachedGif) inlineQueryResult()      {}
+func (InlineQueryResultCachedSticker) inlineQueryResult()  {}
+func (InlineQueryResultCachedDocument) inlineQueryResult() {}
+func (InlineQueryResultCachedVideo) inlineQueryResult()    {}
+func (InlineQueryResultCachedVoice) inlineQueryResult()    {}
+func (InlineQueryResultCachedAudio) inlineQueryResult()    {}
+func (InlineQueryResultGame) inlineQueryResult()           {}
+
+// InputTextMessageContent is a type that represents the content of a text message
+type InputTextMessageContent struct {
+	MessageText           string           `json:"message_text"`
+	ParseMode             string           `json:"parse_mode,omitempty"`
+	Entities              []*MessageEntity `json:"entities,omitempty"`
+	DisableWebPagePreview bool             `json:"disable_web_page_preview,omitempty"`
+}
+
+// InputLocationMessageContent is a type that represents the content of a location message
+type InputLocationMessageContent struct {
+	Latitude             float64 `json:"latitude"`
+	Longitude            float64 `json:"longitude"`
+	HorizontalAccuracy   float64 `json:"horizontal_accuracy,omitempty"`
+	LivePeriod           int64   `json:"live_period,omitempty"`
+	Heading              int64   `json:"heading,omitempty"`
+	ProximityAlertRadius int64   `json:"proximity_alert_radius,omitempty"`
+}
+
+// InputVenueMessageContent is a type that represents the content of a venue message
+type InputVenueMessageContent struct {
+	Latitude        float64 `json:"latitude"`
+	Longitude       float64 `json:"longitude"`
+	Title           string  `json:"title"`
+	Address         string  `json:"address"`
+	FoursquareID    string  `json:"foursquare_id,omitempty"`
+	FoursquareType  string  `json:"foursquare_type,omitempty"`
+	GooglePlaceID   string  `json:"google_place_id,omitempty"`
+	GooglePlaceType string  `json:"google_place_type,omitempty"`
+}
+
+// InputContactMessageContent is a type that represents the content of a contact message
+type InputContactMessageContent struct {
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name,omitempty"`
+	VCard       string `json:"vcard,omitempty"`
+}
+
+// InputInvoiceMessageContent is a type that represents the content of an invoice message
+type InputInvoiceMessageContent struct {
+	Title                     string          `json:"title"`
+	Description               string          `json:"description"`
+	Payload                   string          `json:"payload"`
+	ProviderToken             string          `json:"provider_token"`
+	Currency                  string          `json:"currency"`
+	Prices                    []*LabeledPrice `json:"prices"`
+	MaxTipAmount              int64           `json:"max_tip_amount,omitempty"`
+	SuggestedTipAmounts       []int64         `json:"suggested_tip_amounts,omitempty"`
+	ProviderData              string          `json:"provider_data,omitempty"`
+	PhotoURL                  string          `json:"photo_url,omitempty"`
+	PhotoSize                 int64           `json:"photo_size,omitempty"`
+	PhotoWidth                int64           `json:"photo_width,omitempty"`
+	PhotoHeight               int64           `json:"photo_height,omitempty"`
+	NeedName                  bool            `json:"need_name,omitempty"`
+	NeedPhoneNumber           bool            `json:"need_phone_number,omitempty"`
+	NeedEmail                 bool            `json:"need_email,omitempty"`
+	NeedShippingAddress       bool            `json:"need_shipping_address,omitempty"`
+	SendPhoneNumberToProvider bool            `json:"send_phone_number_to_provider,omitempty"`
+	SendEmailToProvider       bool            `json:"send_email_to_provider,omitempty"`
+	IsFlexible                bool            `json:"is_flexible,omitempty"`
+}
+
+func (InputTextMessageContent) inputMessageContent()     {}
+func (InputLocationMessageContent) inputMessageContent() {}
+func (InputVenueMessageContent) inputMessageContent()    {}
+func (InputContactMessageContent) inputMessageContent()  {}
+func (InputInvoiceMessageContent) inputMessageContent()  {}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// InputFile is a type that represents a file to be sent, either by uploading it or by referencing it
+/* Files referenced by a file id or an url are sent as a string, the other ones are uploaded using multipart/form-data */
+type InputFile struct {
+	value  string // file id or url
+	path   string
+	reader io.Reader
+	data   []byte
+	name   string
+}
+
+// NewInputFilePath is a function that returns an input file that uploads the file found at the path
+func NewInputFilePath(path string) *InputFile {
+	return &InputFile{path: path, name: filepath.Base(path)}
+}
+
+// NewInputFileReader is a function that returns an input file that uploads the content of the reader
+/* The reader can only be read once, so requests uploading it are not retried */
+func NewInputFileReader(name string, reader io.Reader) *InputFile {
+	return &InputFile{reader: reader, name: name}
+}
+
+// NewInputFileBytes is a function that returns an input file that uploads the given bytes
+func NewInputFileBytes(name string, data []byte) *InputFile {
+	return &InputFile{data: data, name: name}
+}
+
+// NewInputFileID is a function that returns an input file that references a file already stored on telegram servers
+func NewInputFileID(fileID string) *InputFile {
+	return &InputFile{value: fileID}
+}
+
+// NewInputFileURL is a function that returns an input file that telegram downloads from the url
+func NewInputFileURL(url string) *InputFile {
+	return &InputFile{value: url}
+}
+
+// NeedsUpload is a method that checks if the input file has to be uploaded using multipart/form-data
+func (file *InputFile) NeedsUpload() bool {
+	return file.value == ""
+}
+
+// Value is a method that returns the file id or url of the input file, empty if the file has to be uploaded
+func (file *InputFile) Value() string {
+	return file.value
+}
+
+// Name is a method that returns the file name used for uploading the input file
+func (file *InputFile) Name() string {
+	if file.name == "" {
+		return "file"
+	}
+	return file.name
+}
+
+// Replayable is a method that checks if the input file can be sent more than once, which is required for retrying
+func (file *InputFile) Replayable() bool {
+	return file.reader == nil
+}
+
+// Open is a method that returns a reader of the content to be uploaded, the caller should close it
+func (file *InputFile) Open() (io.ReadCloser, error) {
+
+	switch {
+	case file.path != "":
+		return os.Open(file.path)
+	case file.reader != nil:
+		return ioutil.NopCloser(file.reader), nil
+	case file.data != nil:
+		return ioutil.NopCloser(bytes.NewReader(file.data)), nil
+	}
+
+	return nil, errors.New("input file has no content to upload")
+}
+
+// String is a method that returns a string representation of the input file, used for logging
+func (file *InputFile) String() string {
+
+	if file == nil {
+		return ""
+	} else if !file.NeedsUpload() {
+		return file.value
+	}
+
+	return "upload:" + file.Name()
+}
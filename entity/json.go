@@ -0,0 +1,208 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// UnmarshalJSON is a method that decodes a MessageResponse, accepting a boolean result in place of a message
+/* Telegram returns true instead of the edited message when an inline message is edited */
+func (response *MessageResponse) UnmarshalJSON(data []byte) error {
+
+	// Using a type without the UnmarshalJSON method, so the decoding doesn't recurse
+	type messageResponse MessageResponse
+	decoded := struct {
+		*messageResponse
+		Result json.RawMessage `json:"result"`
+	}{messageResponse: (*messageResponse)(response)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	result := bytes.TrimSpace(decoded.Result)
+	if len(result) == 0 || bytes.Equal(result, []byte("true")) || bytes.Equal(result, []byte("false")) ||
+		bytes.Equal(result, []byte("null")) {
+		response.Result = Message{}
+		return nil
+	}
+
+	return json.Unmarshal(result, &response.Result)
+}
+
+// UnmarshalJSON is a method that decodes a ChatMemberResponse, using the chat member type matching the status
+func (response *ChatMemberResponse) UnmarshalJSON(data []byte) error {
+
+	type chatMemberResponse ChatMemberResponse
+	decoded := struct {
+		*chatMemberResponse
+		Result json.RawMessage `json:"result"`
+	}{chatMemberResponse: (*chatMemberResponse)(response)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	member, err := unmarshalChatMember(decoded.Result)
+	if err != nil {
+		return err
+	}
+
+	response.Result = member
+	return nil
+}
+
+// UnmarshalJSON is a method that decodes a ChatMembersResponse, using the chat member type matching each status
+func (response *ChatMembersResponse) UnmarshalJSON(data []byte) error {
+
+	type chatMembersResponse ChatMembersResponse
+	decoded := struct {
+		*chatMembersResponse
+		Result []json.RawMessage `json:"result"`
+	}{chatMembersResponse: (*chatMembersResponse)(response)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	response.Result = nil
+	for _, result := range decoded.Result {
+		member, err := unmarshalChatMember(result)
+		if err != nil {
+			return err
+		}
+
+		response.Result = append(response.Result, member)
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a method that decodes a ChatMemberUpdated, using the chat member types matching the statuses
+func (update *ChatMemberUpdated) UnmarshalJSON(data []byte) error {
+
+	type chatMemberUpdated ChatMemberUpdated
+	decoded := struct {
+		*chatMemberUpdated
+		OldChatMember json.RawMessage `json:"old_chat_member"`
+		NewChatMember json.RawMessage `json:"new_chat_member"`
+	}{chatMemberUpdated: (*chatMemberUpdated)(update)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var err error
+	if update.OldChatMember, err = unmarshalChatMember(decoded.OldChatMember); err != nil {
+		return err
+	}
+
+	update.NewChatMember, err = unmarshalChatMember(decoded.NewChatMember)
+	return err
+}
+
+// unmarshalChatMember is a function that decodes a chat member into the type matching its status
+/* A missing or null chat member is decoded as nil */
+func unmarshalChatMember(data json.RawMessage) (ChatMember, error) {
+
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	status := struct {
+		Status string `json:"status"`
+	}{}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, err
+	}
+
+	var member ChatMember
+	switch status.Status {
+	case ChatMemberStatusCreator:
+		member = new(ChatMemberOwner)
+	case ChatMemberStatusAdministrator:
+		member = new(ChatMemberAdministrator)
+	case ChatMemberStatusMember:
+		member = new(ChatMemberMember)
+	case ChatMemberStatusRestricted:
+		member = new(ChatMemberRestricted)
+	case ChatMemberStatusLeft:
+		member = new(ChatMemberLeft)
+	case ChatMemberStatusKicked:
+		member = new(ChatMemberBanned)
+	default:
+		// Keeping a copy of the raw object, since the data may be reused by the decoder
+		member = &ChatMemberUnknown{Raw: append(json.RawMessage(nil), data...)}
+	}
+
+	if err := json.Unmarshal(data, member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// MarshalJSON is a method that encodes the ChatMemberOwner, setting its status if it is empty
+func (member ChatMemberOwner) MarshalJSON() ([]byte, error) {
+	type chatMemberOwner ChatMemberOwner
+	if member.Status == "" {
+		member.Status = ChatMemberStatusCreator
+	}
+	return json.Marshal(chatMemberOwner(member))
+}
+
+// MarshalJSON is a method that encodes the ChatMemberAdministrator, setting its status if it is empty
+func (member ChatMemberAdministrator) MarshalJSON() ([]byte, error) {
+	type chatMemberAdministrator ChatMemberAdministrator
+	if member.Status == "" {
+		member.Status = ChatMemberStatusAdministrator
+	}
+	return json.Marshal(chatMemberAdministrator(member))
+}
+
+// MarshalJSON is a method that encodes the ChatMemberMember, setting its status if it is empty
+func (member ChatMemberMember) MarshalJSON() ([]byte, error) {
+	type chatMemberMember ChatMemberMember
+	if member.Status == "" {
+		member.Status = ChatMemberStatusMember
+	}
+	return json.Marshal(chatMemberMember(member))
+}
+
+// MarshalJSON is a method that encodes the ChatMemberRestricted, setting its status if it is empty
+func (member ChatMemberRestricted) MarshalJSON() ([]byte, error) {
+	type chatMemberRestricted ChatMemberRestricted
+	if member.Status == "" {
+		member.Status = ChatMemberStatusRestricted
+	}
+	return json.Marshal(chatMemberRestricted(member))
+}
+
+// MarshalJSON is a method that encodes the ChatMemberLeft, setting its status if it is empty
+func (member ChatMemberLeft) MarshalJSON() ([]byte, error) {
+	type chatMemberLeft ChatMemberLeft
+	if member.Status == "" {
+		member.Status = ChatMemberStatusLeft
+	}
+	return json.Marshal(chatMemberLeft(member))
+}
+
+// MarshalJSON is a method that encodes the ChatMemberBanned, setting its status if it is empty
+func (member ChatMemberBanned) MarshalJSON() ([]byte, error) {
+	type chatMemberBanned ChatMemberBanned
+	if member.Status == "" {
+		member.Status = ChatMemberStatusKicked
+	}
+	return json.Marshal(chatMemberBanned(member))
+}
+
+// MarshalJSON is a method that encodes the ChatMemberUnknown, using the raw object if it was decoded from one
+func (member ChatMemberUnknown) MarshalJSON() ([]byte, error) {
+	if len(member.Raw) != 0 {
+		return member.Raw, nil
+	}
+
+	type chatMemberUnknown ChatMemberUnknown
+	return json.Marshal(chatMemberUnknown(member))
+}
@@ -0,0 +1,212 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMessageResponseUnmarshalJSON(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		data      string
+		messageID int64
+	}{
+		{"message result", `{"ok":true,"result":{"message_id":7,"text":"hi"}}`, 7},
+		{"true result", `{"ok":true,"result":true}`, 0},
+		{"false result", `{"ok":true,"result":false}`, 0},
+		{"null result", `{"ok":true,"result":null}`, 0},
+		{"missing result", `{"ok":false,"error_code":400,"description":"Bad Request"}`, 0},
+	}
+
+	for _, test := range tests {
+
+		// Starting from a filled response, so a boolean result is checked to clear the previous message
+		response := MessageResponse{Result: Message{MessageID: 3}}
+		if err := json.Unmarshal([]byte(test.data), &response); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+
+		if response.Result.MessageID != test.messageID {
+			t.Errorf("%s: message id is %d, want %d", test.name, response.Result.MessageID, test.messageID)
+		}
+	}
+
+	response := new(MessageResponse)
+	if err := json.Unmarshal([]byte(`{"ok":true,"result":{"message_id":7}}`), response); err != nil ||
+		!response.Ok {
+		t.Errorf("ok is %t with error %v, want the response fields to be decoded", response.Ok, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"ok":true,"result":"sent"}`), response); err == nil {
+		t.Error("expected an error for a string result")
+	}
+}
+
+func TestInlineQueryResultMarshalJSON(t *testing.T) {
+
+	tests := []struct {
+		result     InlineQueryResult
+		resultType string
+	}{
+		{InlineQueryResultArticle{ID: "1"}, "article"},
+		{InlineQueryResultPhoto{ID: "1"}, "photo"},
+		{InlineQueryResultGif{ID: "1"}, "gif"},
+		{InlineQueryResultVideo{ID: "1"}, "video"},
+		{InlineQueryResultAudio{ID: "1"}, "audio"},
+		{InlineQueryResultDocument{ID: "1"}, "document"},
+		{InlineQueryResultLocation{ID: "1"}, "location"},
+		{InlineQueryResultVenue{ID: "1"}, "venue"},
+		{InlineQueryResultContact{ID: "1"}, "contact"},
+		{InlineQueryResultCachedPhoto{ID: "1"}, "photo"},
+		{InlineQueryResultCachedGif{ID: "1"}, "gif"},
+		{InlineQueryResultCachedSticker{ID: "1"}, "sticker"},
+		{InlineQueryResultCachedDocument{ID: "1"}, "document"},
+		{InlineQueryResultCachedVideo{ID: "1"}, "video"},
+		{InlineQueryResultCachedVoice{ID: "1"}, "voice"},
+		{InlineQueryResultCachedAudio{ID: "1"}, "audio"},
+		{InlineQueryResultGame{ID: "1"}, "game"},
+		{&InlineQueryResultArticle{ID: "1"}, "article"},
+		{InlineQueryResultPhoto{Type: "custom", ID: "1"}, "custom"},
+	}
+
+	for _, test := range tests {
+		encoded, err := json.Marshal(test.result)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		}
+		if err = json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		// Results created without a type are encoded with the type of their struct
+		if decoded.Type != test.resultType || decoded.ID != "1" {
+			t.Errorf("%T was encoded as %s, want the type %q", test.result, encoded, test.resultType)
+		}
+	}
+}
+
+func TestChatMemberRoundTrip(t *testing.T) {
+
+	data := `{"ok":true,"result":[` +
+		`{"status":"creator","user":{"id":1,"first_name":"a"},"is_anonymous":true,"custom_title":"owner"},` +
+		`{"status":"administrator","user":{"id":2},"can_manage_chat":true,"can_restrict_members":true},` +
+		`{"status":"member","user":{"id":3}},` +
+		`{"status":"restricted","user":{"id":4},"is_member":true,"until_date":100},` +
+		`{"status":"left","user":{"id":5}},` +
+		`{"status":"kicked","user":{"id":6},"until_date":0},` +
+		`{"status":"guest","user":{"id":7},"guest_since":100}]}`
+
+	response := new(ChatMembersResponse)
+	if err := json.Unmarshal([]byte(data), response); err != nil {
+		t.Fatal(err)
+	}
+
+	wantTypes := []string{"*entity.ChatMemberOwner", "*entity.ChatMemberAdministrator",
+		"*entity.ChatMemberMember", "*entity.ChatMemberRestricted", "*entity.ChatMemberLeft",
+		"*entity.ChatMemberBanned", "*entity.ChatMemberUnknown"}
+	if len(response.Result) != len(wantTypes) {
+		t.Fatalf("decoded %d chat members, want %d", len(response.Result), len(wantTypes))
+	}
+
+	for i, member := range response.Result {
+		if memberType := fmt.Sprintf("%T", member); memberType != wantTypes[i] {
+			t.Errorf("chat member %d is %s, want %s", i, memberType, wantTypes[i])
+		}
+
+		if member.MemberUser().ID != int64(i+1) {
+			t.Errorf("chat member %d has user %d, want %d", i, member.MemberUser().ID, i+1)
+		}
+	}
+
+	if admin := response.Result[1]; !admin.IsAdmin() || !admin.CanRestrict() {
+		t.Error("administrator should be an admin that can restrict members")
+	}
+
+	if restricted := response.Result[3]; !restricted.IsRestrictedUntil(99) || restricted.IsRestrictedUntil(100) {
+		t.Error("restricted member should be restricted until 100")
+	}
+
+	if unknown := response.Result[6]; unknown.MemberStatus() != "guest" || unknown.IsAdmin() {
+		t.Errorf("unknown chat member has status %q, want the raw status", unknown.MemberStatus())
+	}
+
+	encoded, err := json.Marshal(response)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := new(ChatMembersResponse)
+	if err = json.Unmarshal(encoded, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(response, decoded) {
+		t.Errorf("chat members changed after encoding\n%s", encoded)
+	}
+
+	// Unknown chat members are encoded as they were received, keeping the fields that aren't known
+	if !strings.Contains(string(encoded), `{"status":"guest","user":{"id":7},"guest_since":100}`) {
+		t.Errorf("unknown chat member wasn't encoded as received\n%s", encoded)
+	}
+}
+
+func TestChatMemberMarshalStatus(t *testing.T) {
+
+	members := []ChatMember{ChatMemberOwner{}, ChatMemberAdministrator{}, ChatMemberMember{},
+		ChatMemberRestricted{}, ChatMemberLeft{}, ChatMemberBanned{}}
+
+	// Chat members created without a status are encoded with the status of their type
+	for _, member := range members {
+		encoded, err := json.Marshal(member)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		response := new(ChatMemberResponse)
+		if err = json.Unmarshal([]byte(`{"ok":true,"result":`+string(encoded)+`}`), response); err != nil {
+			t.Fatal(err)
+		}
+
+		if reflect.TypeOf(response.Result).Elem() != reflect.TypeOf(member) {
+			t.Errorf("%T was decoded as %T", member, response.Result)
+		}
+	}
+}
+
+func TestChatMemberUpdatedUnmarshalJSON(t *testing.T) {
+
+	data := `{"chat":{"id":-5},"date":100,"old_chat_member":{"status":"left","user":{"id":1}},` +
+		`"new_chat_member":{"status":"newcomer","user":{"id":1}}}`
+
+	update := new(ChatMemberUpdated)
+	if err := json.Unmarshal([]byte(data), update); err != nil {
+		t.Fatalf("unknown status failed the decoding, %v", err)
+	}
+
+	if _, ok := update.OldChatMember.(*ChatMemberLeft); !ok {
+		t.Errorf("old chat member is %T, want *entity.ChatMemberLeft", update.OldChatMember)
+	}
+
+	if update.NewChatMember.MemberStatus() != "newcomer" {
+		t.Errorf("new chat member has status %q, want newcomer", update.NewChatMember.MemberStatus())
+	}
+
+	update = new(ChatMemberUpdated)
+	if err := json.Unmarshal([]byte(`{"chat":{"id":-5}}`), update); err != nil {
+		t.Fatal(err)
+	}
+
+	if update.OldChatMember != nil || update.NewChatMember != nil || update.Joined() || update.Left() {
+		t.Error("missing chat members should be decoded as nil")
+	}
+}
@@ -0,0 +1,273 @@
+package entity
+
+// MemberStatus is a method that returns the status of the chat owner
+func (member ChatMemberOwner) MemberStatus() string {
+	return ChatMemberStatusCreator
+}
+
+// MemberUser is a method that returns information about the chat owner
+func (member ChatMemberOwner) MemberUser() User {
+	return member.User
+}
+
+// IsAdmin is a method that checks if the chat member is an administrator, which the owner always is
+func (member ChatMemberOwner) IsAdmin() bool {
+	return true
+}
+
+// CanRestrict is a method that checks if the chat member can restrict, ban and unban users
+func (member ChatMemberOwner) CanRestrict() bool {
+	return true
+}
+
+// IsRestrictedUntil is a method that checks if the chat member is restricted at the given unix time
+func (member ChatMemberOwner) IsRestrictedUntil(date int64) bool {
+	return false
+}
+
+// MemberStatus is a method that returns the status of the chat administrator
+func (member ChatMemberAdministrator) MemberStatus() string {
+	return ChatMemberStatusAdministrator
+}
+
+// MemberUser is a method that returns information about the chat administrator
+func (member ChatMemberAdministrator) MemberUser() User {
+	return member.User
+}
+
+// IsAdmin is a method that checks if the chat member is an administrator
+func (member ChatMemberAdministrator) IsAdmin() bool {
+	return true
+}
+
+// CanRestrict is a method that checks if the administrator has the right to restrict, ban and unban users
+func (member ChatMemberAdministrator) CanRestrict() bool {
+	return member.CanRestrictMembers
+}
+
+// IsRestrictedUntil is a method that checks if the chat member is restricted at the given unix time
+func (member ChatMemberAdministrator) IsRestrictedUntil(date int64) bool {
+	return false
+}
+
+// MemberStatus is a method that returns the status of the chat member
+func (member ChatMemberMember) MemberStatus() string {
+	return ChatMemberStatusMember
+}
+
+// MemberUser is a method that returns information about the chat member
+func (member ChatMemberMember) MemberUser() User {
+	return member.User
+}
+
+// IsAdmin is a method that checks if the chat member is an administrator
+func (member ChatMemberMember) IsAdmin() bool {
+	return false
+}
+
+// CanRestrict is a method that checks if the chat member can restrict, ban and unban users
+func (member ChatMemberMember) CanRestrict() bool {
+	return false
+}
+
+// IsRestrictedUntil is a method that checks if the chat member is restricted at the given unix time
+func (member ChatMemberMember) IsRestrictedUntil(date int64) bool {
+	return false
+}
+
+// MemberStatus is a method that returns the status of the restricted chat member
+func (member ChatMemberRestricted) MemberStatus() string {
+	return ChatMemberStatusRestricted
+}
+
+// MemberUser is a method that returns information about the restricted chat member
+func (member ChatMemberRestricted) MemberUser() User {
+	return member.User
+}
+
+// IsAdmin is a method that checks if the chat member is an administrator
+func (member ChatMemberRestricted) IsAdmin() bool {
+	return false
+}
+
+// CanRestrict is a method that checks if the chat member can restrict, ban and unban users
+func (member ChatMemberRestricted) CanRestrict() bool {
+	return false
+}
+
+// IsRestrictedUntil is a method that checks if the restrictions still apply at the given unix time
+/* Restrictions with a zero until date never expire */
+func (member ChatMemberRestricted) IsRestrictedUntil(date int64) bool {
+	return member.UntilDate == 0 || member.UntilDate > date
+}
+
+// MemberStatus is a method that returns the status of the user that left the chat
+func (member ChatMemberLeft) MemberStatus() string {
+	return ChatMemberStatusLeft
+}
+
+// MemberUser is a method that returns information about the user that left the chat
+func (member ChatMemberLeft) MemberUser() User {
+	return member.User
+}
+
+// IsAdmin is a method that checks if the chat member is an administrator
+func (member ChatMemberLeft) IsAdmin() bool {
+	return false
+}
+
+// CanRestrict is a method that checks if the chat member can restrict, ban and unban users
+func (member ChatMemberLeft) CanRestrict() bool {
+	return false
+}
+
+// IsRestrictedUntil is a method that checks if the chat member is restricted at the given unix time
+func (member ChatMemberLeft) IsRestrictedUntil(date int64) bool {
+	return false
+}
+
+// MemberStatus is a method that returns the status of the banned user
+func (member ChatMemberBanned) MemberStatus() string {
+	return ChatMemberStatusKicked
+}
+
+// MemberUser is a method that returns information about the banned user
+func (member ChatMemberBanned) MemberUser() User {
+	return member.User
+}
+
+// IsAdmin is a method that checks if the chat member is an administrator
+func (member ChatMemberBanned) IsAdmin() bool {
+	return false
+}
+
+// CanRestrict is a method that checks if the chat member can restrict, ban and unban users
+func (member ChatMemberBanned) CanRestrict() bool {
+	return false
+}
+
+// IsRestrictedUntil is a method that checks if the ban still applies at the given unix time
+/* Bans with a zero until date never expire */
+func (member ChatMemberBanned) IsRestrictedUntil(date int64) bool {
+	return member.UntilDate == 0 || member.UntilDate > date
+}
+
+// MemberStatus is a method that returns the raw status of the chat member
+func (member ChatMemberUnknown) MemberStatus() string {
+	return member.Status
+}
+
+// MemberUser is a method that returns information about the chat member
+func (member ChatMemberUnknown) MemberUser() User {
+	return member.User
+}
+
+// IsAdmin is a method that checks if the chat member is an administrator
+func (member ChatMemberUnknown) IsAdmin() bool {
+	return false
+}
+
+// CanRestrict is a method that checks if the chat member can restrict, ban and unban users
+func (member ChatMemberUnknown) CanRestrict() bool {
+	return false
+}
+
+// IsRestrictedUntil is a method that checks if the chat member is restricted at the given unix time
+func (member ChatMemberUnknown) IsRestrictedUntil(date int64) bool {
+	return false
+}
+
+// Joined is a method that checks if the user became a member of the chat, by joining or by being added
+func (update *ChatMemberUpdated) Joined() bool {
+	return !isChatMemberPresent(update.OldChatMember) && isChatMemberPresent(update.NewChatMember)
+}
+
+// Left is a method that checks if the user stopped being a member of the chat without being banned
+/* It covers both users leaving the chat and users removed from the chat by an administrator */
+func (update *ChatMemberUpdated) Left() bool {
+	return isChatMemberPresent(update.OldChatMember) && !isChatMemberPresent(update.NewChatMember) &&
+		chatMemberStatus(update.NewChatMember) != ChatMemberStatusKicked
+}
+
+// Banned is a method that checks if the user was banned from the chat
+func (update *ChatMemberUpdated) Banned() bool {
+	return chatMemberStatus(update.OldChatMember) != ChatMemberStatusKicked &&
+		chatMemberStatus(update.NewChatMember) == ChatMemberStatusKicked
+}
+
+// Unbanned is a method that checks if the user was removed from the list of banned users of the chat
+func (update *ChatMemberUpdated) Unbanned() bool {
+	return chatMemberStatus(update.OldChatMember) == ChatMemberStatusKicked &&
+		chatMemberStatus(update.NewChatMember) != ChatMemberStatusKicked
+}
+
+// Promoted is a method that checks if the user became an administrator or the owner of the chat
+func (update *ChatMemberUpdated) Promoted() bool {
+	return !isChatMemberAdmin(update.OldChatMember) && isChatMemberAdmin(update.NewChatMember)
+}
+
+// Demoted is a method that checks if the user stopped being an administrator while staying in the chat
+func (update *ChatMemberUpdated) Demoted() bool {
+	return isChatMemberAdmin(update.OldChatMember) && !isChatMemberAdmin(update.NewChatMember) &&
+		isChatMemberPresent(update.NewChatMember)
+}
+
+// Restricted is a method that checks if restrictions were applied to the user
+/* Changes to the restrictions of an already restricted user aren't reported as a new restriction */
+func (update *ChatMemberUpdated) Restricted() bool {
+	return chatMemberStatus(update.OldChatMember) != ChatMemberStatusRestricted &&
+		chatMemberStatus(update.NewChatMember) == ChatMemberStatusRestricted
+}
+
+// Unrestricted is a method that checks if the restrictions of the user were lifted while staying in the chat
+func (update *ChatMemberUpdated) Unrestricted() bool {
+	return chatMemberStatus(update.OldChatMember) == ChatMemberStatusRestricted &&
+		chatMemberStatus(update.NewChatMember) != ChatMemberStatusRestricted && isChatMemberPresent(update.NewChatMember)
+}
+
+// BotAdded is a method that checks if a bot was added to the chat
+/* For 'my_chat_member' updates the bot is the one receiving the update */
+func (update *ChatMemberUpdated) BotAdded() bool {
+	return update.NewChatMember != nil && update.NewChatMember.MemberUser().IsBot && update.Joined()
+}
+
+// BotRemoved is a method that checks if a bot was removed or banned from the chat
+/* For 'my_chat_member' updates the bot is the one receiving the update, which includes private chats */
+/* where the user blocked the bot */
+func (update *ChatMemberUpdated) BotRemoved() bool {
+	return update.NewChatMember != nil && update.NewChatMember.MemberUser().IsBot &&
+		isChatMemberPresent(update.OldChatMember) && !isChatMemberPresent(update.NewChatMember)
+}
+
+// chatMemberStatus is a function that returns the status of the chat member, empty if there is no chat member
+func chatMemberStatus(member ChatMember) string {
+
+	if member == nil {
+		return ""
+	}
+
+	return member.MemberStatus()
+}
+
+// isChatMemberPresent is a function that checks if the chat member is currently a member of the chat
+/* Restricted users are only members of the chat if their 'is member' flag is set */
+func isChatMemberPresent(member ChatMember) bool {
+
+	switch value := member.(type) {
+	case *ChatMemberRestricted:
+		return value.IsMember
+	case ChatMemberRestricted:
+		return value.IsMember
+	case nil:
+		return false
+	}
+
+	status := member.MemberStatus()
+	return status == ChatMemberStatusCreator || status == ChatMemberStatusAdministrator ||
+		status == ChatMemberStatusMember
+}
+
+// isChatMemberAdmin is a function that checks if the chat member is an administrator or the owner of the chat
+func isChatMemberAdmin(member ChatMember) bool {
+	return member != nil && member.IsAdmin()
+}
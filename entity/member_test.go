@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// chatMemberTransitions is a function that returns the names of the transitions reported by the update
+func chatMemberTransitions(update *ChatMemberUpdated) string {
+
+	checks := []struct {
+		name   string
+		result bool
+	}{
+		{"joined", update.Joined()}, {"left", update.Left()}, {"banned", update.Banned()},
+		{"unbanned", update.Unbanned()}, {"promoted", update.Promoted()}, {"demoted", update.Demoted()},
+		{"restricted", update.Restricted()}, {"unrestricted", update.Unrestricted()},
+		{"bot added", update.BotAdded()}, {"bot removed", update.BotRemoved()},
+	}
+
+	var names []string
+	for _, check := range checks {
+		if check.result {
+			names = append(names, check.name)
+		}
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func TestChatMemberUpdatedTransitions(t *testing.T) {
+
+	tests := []struct {
+		old  string
+		new  string
+		bot  bool
+		want string
+	}{
+		{`"status":"left"`, `"status":"member"`, false, "joined"},
+		{`"status":"member"`, `"status":"left"`, false, "left"},
+		{`"status":"member"`, `"status":"kicked"`, false, "banned"},
+		{`"status":"kicked"`, `"status":"left"`, false, "unbanned"},
+		{`"status":"kicked"`, `"status":"member"`, false, "joined, unbanned"},
+		{`"status":"member"`, `"status":"administrator"`, false, "promoted"},
+		{`"status":"left"`, `"status":"creator"`, false, "joined, promoted"},
+		{`"status":"administrator"`, `"status":"member"`, false, "demoted"},
+		{`"status":"administrator"`, `"status":"kicked"`, false, "banned"},
+		{`"status":"member"`, `"status":"restricted","is_member":true`, false, "restricted"},
+		{`"status":"member"`, `"status":"restricted","is_member":false`, false, "left, restricted"},
+		{`"status":"restricted","is_member":true`, `"status":"member"`, false, "unrestricted"},
+		{`"status":"restricted","is_member":false`, `"status":"member"`, false, "joined, unrestricted"},
+		{`"status":"restricted","is_member":true`, `"status":"restricted","is_member":false`, false, "left"},
+		{`"status":"restricted","is_member":false`, `"status":"left"`, false, ""},
+		{`"status":"member"`, `"status":"member"`, false, ""},
+		{`"status":"left"`, `"status":"member"`, true, "joined, bot added"},
+		{`"status":"left"`, `"status":"administrator"`, true, "joined, promoted, bot added"},
+		{`"status":"member"`, `"status":"kicked"`, true, "banned, bot removed"},
+		{`"status":"administrator"`, `"status":"left"`, true, "left, bot removed"},
+	}
+
+	for _, test := range tests {
+
+		user := fmt.Sprintf(`"user":{"id":5,"is_bot":%t}`, test.bot)
+		data := `{"chat":{"id":-5},"date":100,"old_chat_member":{` + test.old + `,` + user + `},` +
+			`"new_chat_member":{` + test.new + `,` + user + `}}`
+
+		update := new(ChatMemberUpdated)
+		if err := json.Unmarshal([]byte(data), update); err != nil {
+			t.Fatal(err)
+		}
+
+		if got := chatMemberTransitions(update); got != test.want {
+			t.Errorf("{%s} => {%s}, bot %t: transitions are %q, want %q", test.old, test.new, test.bot, got,
+				test.want)
+		}
+	}
+}
@@ -15,6 +15,16 @@ func (response *MessageResponse) ToString() string {
 	return string(output)
 }
 
+// ToString is a method that converts a MessagesResponse struct to readable JSON string format
+func (response *MessagesResponse) ToString() string {
+	output, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprint(response)
+	}
+
+	return string(output)
+}
+
 // ToString is a method that converts a ChatPermissions struct to readable JSON string format
 func (response *ChatPermissions) ToString() string {
 	output, err := json.Marshal(response)
@@ -74,3 +84,73 @@ func (response *ChatDefaultResponse) ToString() string {
 
 	return string(output)
 }
+
+// ToString is a method that converts a UpdatesResponse struct to readable JSON string format
+func (response *UpdatesResponse) ToString() string {
+	output, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprint(response)
+	}
+
+	return string(output)
+}
+
+// ToString is a method that converts a WebhookInfoResponse struct to readable JSON string format
+func (response *WebhookInfoResponse) ToString() string {
+	output, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprint(response)
+	}
+
+	return string(output)
+}
+
+// ToString is a method that converts a FileResponse struct to readable JSON string format
+func (response *FileResponse) ToString() string {
+	output, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprint(response)
+	}
+
+	return string(output)
+}
+
+// ToString is a method that converts a PollResponse struct to readable JSON string format
+func (response *PollResponse) ToString() string {
+	output, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprint(response)
+	}
+
+	return string(output)
+}
+
+// ToString is a method that converts a MessageIDResponse struct to readable JSON string format
+func (response *MessageIDResponse) ToString() string {
+	output, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprint(response)
+	}
+
+	return string(output)
+}
+
+// ToString is a method that converts a StringResponse struct to readable JSON string format
+func (response *StringResponse) ToString() string {
+	output, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprint(response)
+	}
+
+	return string(output)
+}
+
+// ToString is a method that converts a GameHighScoresResponse struct to readable JSON string format
+func (response *GameHighScoresResponse) ToString() string {
+	output, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprint(response)
+	}
+
+	return string(output)
+}
@@ -0,0 +1,106 @@
+package entity
+
+// Type is a method that returns the type of the update, it will be one of the 'UpdateType' constants
+/* An empty string is returned if the update type isn't supported */
+func (update *Update) Type() string {
+
+	// Since the fields aren't pointers the unique identifiers are used for checking the presence of the object
+	switch {
+	case update.Message.MessageID != 0:
+		return UpdateTypeMessage
+	case update.EditedMessage.MessageID != 0:
+		return UpdateTypeEditedMessage
+	case update.ChannelPost.MessageID != 0:
+		return UpdateTypeChannelPost
+	case update.EditedChannelPost.MessageID != 0:
+		return UpdateTypeEditedChannelPost
+	case update.CallbackQuery.ID != "":
+		return UpdateTypeCallbackQuery
+	case update.InlineQuery.ID != "":
+		return UpdateTypeInlineQuery
+	case update.ChosenInlineResult.ResultID != "":
+		return UpdateTypeChosenInlineResult
+	case update.ShippingQuery.ID != "":
+		return UpdateTypeShippingQuery
+	case update.PreCheckoutQuery.ID != "":
+		return UpdateTypePreCheckoutQuery
+	case update.MyChatMember.Chat.ID != 0:
+		return UpdateTypeMyChatMember
+	case update.ChatMember.Chat.ID != 0:
+		return UpdateTypeChatMember
+	case update.ChatJoinRequest.Chat.ID != 0:
+		return UpdateTypeChatJoinRequest
+	}
+
+	return ""
+}
+
+// EffectiveMessage is a method that returns the message the update is related with, nil if there is no message
+/* For callback queries the message the callback button originated from is returned */
+func (update *Update) EffectiveMessage() *Message {
+
+	switch update.Type() {
+	case UpdateTypeMessage:
+		return &update.Message
+	case UpdateTypeEditedMessage:
+		return &update.EditedMessage
+	case UpdateTypeChannelPost:
+		return &update.ChannelPost
+	case UpdateTypeEditedChannelPost:
+		return &update.EditedChannelPost
+	case UpdateTypeCallbackQuery:
+		if update.CallbackQuery.Message.MessageID != 0 {
+			return &update.CallbackQuery.Message
+		}
+	}
+
+	return nil
+}
+
+// EffectiveChat is a method that returns the chat the update is related with, nil if there is no chat
+func (update *Update) EffectiveChat() *Chat {
+
+	switch update.Type() {
+	case UpdateTypeMyChatMember:
+		return &update.MyChatMember.Chat
+	case UpdateTypeChatMember:
+		return &update.ChatMember.Chat
+	case UpdateTypeChatJoinRequest:
+		return &update.ChatJoinRequest.Chat
+	}
+
+	if message := update.EffectiveMessage(); message != nil {
+		return &message.Chat
+	}
+
+	return nil
+}
+
+// EffectiveUser is a method that returns the user that caused the update, nil if there is no user
+func (update *Update) EffectiveUser() *User {
+
+	switch update.Type() {
+	case UpdateTypeCallbackQuery:
+		return &update.CallbackQuery.User
+	case UpdateTypeInlineQuery:
+		return &update.InlineQuery.From
+	case UpdateTypeChosenInlineResult:
+		return &update.ChosenInlineResult.From
+	case UpdateTypeShippingQuery:
+		return &update.ShippingQuery.From
+	case UpdateTypePreCheckoutQuery:
+		return &update.PreCheckoutQuery.From
+	case UpdateTypeMyChatMember:
+		return &update.MyChatMember.From
+	case UpdateTypeChatMember:
+		return &update.ChatMember.From
+	case UpdateTypeChatJoinRequest:
+		return &update.ChatJoinRequest.From
+	}
+
+	if message := update.EffectiveMessage(); message != nil && message.From.ID != 0 {
+		return &message.From
+	}
+
+	return nil
+}
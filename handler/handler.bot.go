@@ -1,10 +1,10 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strconv"
 
@@ -25,6 +25,13 @@ import (
 /* ReplyMarkup              string */
 func (handler *TelegramBotHandler) SendReplyToTelegramChat(chatID interface{}, text string,
 	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendReplyToTelegramChatCtx(context.Background(), chatID, text, optionals)
+}
+
+// SendReplyToTelegramChatCtx is the context aware version of SendReplyToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendReplyToTelegramChatCtx(ctx context.Context, chatID interface{}, text string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
 
 	chatIDS := ""
 	parseMode := ""
@@ -76,7 +83,7 @@ func (handler *TelegramBotHandler) SendReplyToTelegramChat(chatID interface{}, t
 		allowSendingWithoutReply, replyMarkup), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendMessage"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":                     {chatIDS},
@@ -115,6 +122,14 @@ func (handler *TelegramBotHandler) SendReplyToTelegramChat(chatID interface{}, t
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending reply to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished sending reply to telegram chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -134,6 +149,13 @@ func (handler *TelegramBotHandler) SendReplyToTelegramChat(chatID interface{}, t
 /* ReplyMarkup              string */
 func (handler *TelegramBotHandler) EditReplyToTelegramChat(text string,
 	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.EditReplyToTelegramChatCtx(context.Background(), text, optionals)
+}
+
+// EditReplyToTelegramChatCtx is the context aware version of EditReplyToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) EditReplyToTelegramChatCtx(ctx context.Context, text string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
 
 	chatID := ""
 	parseMode := ""
@@ -184,7 +206,7 @@ func (handler *TelegramBotHandler) EditReplyToTelegramChat(text string,
 		entities, disableWebPageView, replyMarkup), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/editMessageText"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":                  {chatID},
@@ -220,6 +242,14 @@ func (handler *TelegramBotHandler) EditReplyToTelegramChat(text string,
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing reply sent to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished editing reply sent to telegram chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -227,9 +257,142 @@ func (handler *TelegramBotHandler) EditReplyToTelegramChat(text string,
 	return botResponse, nil
 }
 
+// SendPhotoToTelegramChat sends a photo to the Telegram chat identified by its chat ID
+/* The photo can be a file id or url string, or an *InputFile for uploading a new photo */
+/* Available Optional Values */
+/* Caption                     string */
+/* ParseMode                   string */
+/* CaptionEntities             []MessageEntity */
+/* HasSpoiler                  bool */
+/* DisableNotification         bool */
+/* ReplyToMessageID            int64 */
+/* ProtectContent              bool */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendPhotoToTelegramChat(chatID interface{}, photo interface{},
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendPhotoToTelegramChatCtx(context.Background(), chatID, photo, optionals)
+}
+
+// SendPhotoToTelegramChatCtx is the context aware version of SendPhotoToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendPhotoToTelegramChatCtx(ctx context.Context, chatID interface{},
+	photo interface{}, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	caption := ""
+	replyMarkup := ""
+	parseMode := ""
+	captionEntities := ""
+	chatIDS := ""
+
+	var hasSpoiler bool
+	var disableNotification bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+	var protectContent bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	files := make(uploadFiles)
+	photoValue, err := files.add("photo", photo, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// If optionals are nil then set the default mode
+	if optionals == nil {
+		parseMode = "html"
+	} else {
+		if len(optionals.CaptionEntities) > 0 {
+			captionEntitiesByte, _ := json.Marshal(optionals.CaptionEntities)
+			captionEntities = string(captionEntitiesByte)
+		}
+
+		caption = optionals.Caption
+		parseMode = optionals.ParseMode
+		hasSpoiler = optionals.HasSpoiler
+		disableNotification = optionals.DisableNotification
+		replyToMessageID = optionals.ReplyToMessageID
+		protectContent = optionals.ProtectContent
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending photo to telegram chat { Chat ID : %s, Photo : %s, "+
+		"Caption : %s, Parse Mode : %s, Caption Entities : %s, Has Spoiler : %v, Disable Notification : %v, "+
+		"Reply To Message ID : %d, Protect Content : %v, Allow Sending Without Reply : %v, Reply Markup : %s }",
+		chatIDS, photo, caption, parseMode, captionEntities, hasSpoiler, disableNotification, replyToMessageID,
+		protectContent, allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendPhoto"
+	response, err := handler.postMultipart(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"photo":                       {photoValue},
+			"caption":                     {caption},
+			"parse_mode":                  {parseMode},
+			"caption_entities":            {captionEntities},
+			"has_spoiler":                 {strconv.FormatBool(hasSpoiler)},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                {replyMarkup},
+		}, files)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending photo to telegram chat { Chat ID : %s, Photo : %s, "+
+			"Caption : %s, Parse Mode : %s, Caption Entities : %s, Has Spoiler : %v, Disable Notification : %v, "+
+			"Reply To Message ID : %d, Protect Content : %v, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, photo, caption, parseMode, captionEntities, hasSpoiler, disableNotification, replyToMessageID,
+			protectContent, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending photo to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Photo : %s, Caption : %s, Parse Mode : %s, Caption Entities : %s, Has Spoiler : %v, "+
+			"Disable Notification : %v, Reply To Message ID : %d, Protect Content : %v, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, photo, caption, parseMode, captionEntities, hasSpoiler, disableNotification, replyToMessageID,
+			protectContent, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending photo to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending photo to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
 // SendDocumentToTelegramChat sends a document to the Telegram chat identified by its chat ID
+/* The document can be a file id or url string, or an *InputFile for uploading a new file */
 /* Available Optional Values */
-/* Thumb                       string */
+/* Thumb                       string or *InputFile */
 /* Caption                     string */
 /* ParseMode                   string */
 /* CaptionEntities             []MessageEntity */
@@ -238,8 +401,15 @@ func (handler *TelegramBotHandler) EditReplyToTelegramChat(text string,
 /* ReplyToMessageID            int64 */
 /* AllowSendingWithoutReply    bool */
 /* ReplyMarkup                 string */
-func (handler *TelegramBotHandler) SendDocumentToTelegramChat(chatID interface{}, fileID string,
+func (handler *TelegramBotHandler) SendDocumentToTelegramChat(chatID interface{}, document interface{},
 	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendDocumentToTelegramChatCtx(context.Background(), chatID, document, optionals)
+}
+
+// SendDocumentToTelegramChatCtx is the context aware version of SendDocumentToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendDocumentToTelegramChatCtx(ctx context.Context, chatID interface{},
+	document interface{}, optionals *entity.Optional) (*entity.MessageResponse, error) {
 
 	caption := ""
 	replyMarkup := ""
@@ -261,6 +431,12 @@ func (handler *TelegramBotHandler) SendDocumentToTelegramChat(chatID interface{}
 		return nil, errors.New("chat id can only be type string or integer")
 	}
 
+	files := make(uploadFiles)
+	documentValue, err := files.add("document", document, false)
+	if err != nil {
+		return nil, err
+	}
+
 	// If optionals are nil then set the default mode
 	if optionals == nil {
 		parseMode = "html"
@@ -270,7 +446,9 @@ func (handler *TelegramBotHandler) SendDocumentToTelegramChat(chatID interface{}
 			captionEntities = string(captionEntitiesByte)
 		}
 
-		thumb = optionals.Thumb
+		if thumb, err = files.add("thumb", optionals.Thumb, true); err != nil {
+			return nil, err
+		}
 		caption = optionals.Caption
 		parseMode = optionals.ParseMode
 		disableContentTypeDetection = optionals.DisableContentTypeDetection
@@ -284,15 +462,15 @@ func (handler *TelegramBotHandler) SendDocumentToTelegramChat(chatID interface{}
 	handler.Logging(fmt.Sprintf("Started sending document to telegram chat { Chat ID : %s, Document : %s, "+
 		"Thumb : %s, Caption : %s, Parse Mode : %s, Caption Entities : %s, Disable Content Type Detection : %v, "+
 		"Disable Notification : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }",
-		chatIDS, fileID, thumb, caption, parseMode, captionEntities, disableContentTypeDetection,
+		chatIDS, document, thumb, caption, parseMode, captionEntities, disableContentTypeDetection,
 		disableNotification, replyToMessageID, allowSendingWithoutReply, replyMarkup), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendDocument"
-	response, err := http.PostForm(
+	response, err := handler.postMultipart(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":                        {chatIDS},
-			"document":                       {fileID},
+			"document":                       {documentValue},
 			"thumb":                          {thumb},
 			"caption":                        {caption},
 			"parse_mode":                     {parseMode},
@@ -302,14 +480,14 @@ func (handler *TelegramBotHandler) SendDocumentToTelegramChat(chatID interface{}
 			"reply_to_message_id":            {strconv.FormatInt(replyToMessageID, 10)},
 			"allow_sending_without_reply":    {strconv.FormatBool(allowSendingWithoutReply)},
 			"reply_markup":                   {replyMarkup},
-		})
+		}, files)
 
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
 		handler.Logging(fmt.Sprintf("Error: For sending document to telegram chat { Chat ID : %s, Document : %s, "+
 			"Thumb : %s, Caption : %s, Parse Mode : %s, Caption Entities : %s, Disable Content Type Detection : %v, "+
 			"Disable Notification : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
-			chatIDS, fileID, thumb, caption, parseMode, captionEntities, disableContentTypeDetection,
+			chatIDS, document, thumb, caption, parseMode, captionEntities, disableContentTypeDetection,
 			disableNotification, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
 
 		return nil, err
@@ -323,12 +501,20 @@ func (handler *TelegramBotHandler) SendDocumentToTelegramChat(chatID interface{}
 		handler.Logging(fmt.Sprintf("Error: For sending document to telegram chat, unable to parse response { Chat ID : %s, Document : %s, "+
 			"Thumb : %s, Caption : %s, Parse Mode : %s, Caption Entities : %s, Disable Content Type Detection : %v, "+
 			"Disable Notification : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
-			chatIDS, fileID, thumb, caption, parseMode, captionEntities, disableContentTypeDetection,
+			chatIDS, document, thumb, caption, parseMode, captionEntities, disableContentTypeDetection,
 			disableNotification, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
 
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending document to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished sending document to telegram chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -337,11 +523,12 @@ func (handler *TelegramBotHandler) SendDocumentToTelegramChat(chatID interface{}
 }
 
 // SendVideoToTelegramChat sends a video files to the Telegram chat identified by its chat ID
+/* The video can be a file id or url string, or an *InputFile for uploading a new file */
 /* Available Optional Values */
 /* Duration                    int64 */
 /* Width                       int64 */
 /* Height                      int64 */
-/* Thumb                       string */
+/* Thumb                       string or *InputFile */
 /* Caption                     string */
 /* ParseMode                   string */
 /* CaptionEntities             []MessageEntity */
@@ -351,8 +538,15 @@ func (handler *TelegramBotHandler) SendDocumentToTelegramChat(chatID interface{}
 /* ProtectContent              bool */
 /* AllowSendingWithoutReply    bool */
 /* ReplyMarkup                 string */
-func (handler *TelegramBotHandler) SendVideoToTelegramChat(chatID interface{}, video string,
+func (handler *TelegramBotHandler) SendVideoToTelegramChat(chatID interface{}, video interface{},
 	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendVideoToTelegramChatCtx(context.Background(), chatID, video, optionals)
+}
+
+// SendVideoToTelegramChatCtx is the context aware version of SendVideoToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendVideoToTelegramChatCtx(ctx context.Context, chatID interface{},
+	video interface{}, optionals *entity.Optional) (*entity.MessageResponse, error) {
 
 	caption := ""
 	replyMarkup := ""
@@ -378,6 +572,12 @@ func (handler *TelegramBotHandler) SendVideoToTelegramChat(chatID interface{}, v
 		return nil, errors.New("chat id can only be type string or integer")
 	}
 
+	files := make(uploadFiles)
+	videoValue, err := files.add("video", video, false)
+	if err != nil {
+		return nil, err
+	}
+
 	// If optionals are nil then set the default mode
 	if optionals == nil {
 		parseMode = "html"
@@ -390,7 +590,9 @@ func (handler *TelegramBotHandler) SendVideoToTelegramChat(chatID interface{}, v
 		duration = optionals.Duration
 		width = optionals.Width
 		height = optionals.Height
-		thumb = optionals.Thumb
+		if thumb, err = files.add("thumb", optionals.Thumb, true); err != nil {
+			return nil, err
+		}
 		caption = optionals.Caption
 		parseMode = optionals.ParseMode
 		disableContentTypeDetection = optionals.DisableContentTypeDetection
@@ -411,11 +613,11 @@ func (handler *TelegramBotHandler) SendVideoToTelegramChat(chatID interface{}, v
 		allowSendingWithoutReply, replyMarkup), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendVideo"
-	response, err := http.PostForm(
+	response, err := handler.postMultipart(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":                        {chatIDS},
-			"video":                          {video},
+			"video":                          {videoValue},
 			"duration":                       {strconv.FormatInt(duration, 10)},
 			"width":                          {strconv.FormatInt(width, 10)},
 			"height":                         {strconv.FormatInt(height, 10)},
@@ -429,7 +631,7 @@ func (handler *TelegramBotHandler) SendVideoToTelegramChat(chatID interface{}, v
 			"reply_to_message_id":            {strconv.FormatInt(replyToMessageID, 10)},
 			"allow_sending_without_reply":    {strconv.FormatBool(allowSendingWithoutReply)},
 			"reply_markup":                   {replyMarkup},
-		})
+		}, files)
 
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
@@ -460,6 +662,14 @@ func (handler *TelegramBotHandler) SendVideoToTelegramChat(chatID interface{}, v
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending video to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished sending video to telegram chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -468,11 +678,12 @@ func (handler *TelegramBotHandler) SendVideoToTelegramChat(chatID interface{}, v
 }
 
 // SendAnimationToTelegramChat sends a animation files to the Telegram chat identified by its chat ID
+/* The animation can be a file id or url string, or an *InputFile for uploading a new file */
 /* Available Optional Values */
 /* Duration                    int64 */
 /* Width                       int64 */
 /* Height                      int64 */
-/* Thumb                       string */
+/* Thumb                       string or *InputFile */
 /* Caption                     string */
 /* ParseMode                   string */
 /* CaptionEntities             []MessageEntity */
@@ -482,8 +693,15 @@ func (handler *TelegramBotHandler) SendVideoToTelegramChat(chatID interface{}, v
 /* ProtectContent              bool */
 /* AllowSendingWithoutReply    bool */
 /* ReplyMarkup                 string */
-func (handler *TelegramBotHandler) SendAnimationToTelegramChat(chatID interface{}, animation string,
+func (handler *TelegramBotHandler) SendAnimationToTelegramChat(chatID interface{}, animation interface{},
 	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendAnimationToTelegramChatCtx(context.Background(), chatID, animation, optionals)
+}
+
+// SendAnimationToTelegramChatCtx is the context aware version of SendAnimationToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendAnimationToTelegramChatCtx(ctx context.Context, chatID interface{},
+	animation interface{}, optionals *entity.Optional) (*entity.MessageResponse, error) {
 
 	caption := ""
 	replyMarkup := ""
@@ -509,6 +727,12 @@ func (handler *TelegramBotHandler) SendAnimationToTelegramChat(chatID interface{
 		return nil, errors.New("chat id can only be type string or integer")
 	}
 
+	files := make(uploadFiles)
+	animationValue, err := files.add("animation", animation, false)
+	if err != nil {
+		return nil, err
+	}
+
 	// If optionals are nil then set the default mode
 	if optionals == nil {
 		parseMode = "html"
@@ -521,7 +745,9 @@ func (handler *TelegramBotHandler) SendAnimationToTelegramChat(chatID interface{
 		duration = optionals.Duration
 		width = optionals.Width
 		height = optionals.Height
-		thumb = optionals.Thumb
+		if thumb, err = files.add("thumb", optionals.Thumb, true); err != nil {
+			return nil, err
+		}
 		caption = optionals.Caption
 		parseMode = optionals.ParseMode
 		disableContentTypeDetection = optionals.DisableContentTypeDetection
@@ -542,11 +768,11 @@ func (handler *TelegramBotHandler) SendAnimationToTelegramChat(chatID interface{
 		allowSendingWithoutReply, replyMarkup), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendAnimation"
-	response, err := http.PostForm(
+	response, err := handler.postMultipart(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":                        {chatIDS},
-			"animation":                      {animation},
+			"animation":                      {animationValue},
 			"duration":                       {strconv.FormatInt(duration, 10)},
 			"width":                          {strconv.FormatInt(width, 10)},
 			"height":                         {strconv.FormatInt(height, 10)},
@@ -560,17 +786,2000 @@ func (handler *TelegramBotHandler) SendAnimationToTelegramChat(chatID interface{
 			"reply_to_message_id":            {strconv.FormatInt(replyToMessageID, 10)},
 			"allow_sending_without_reply":    {strconv.FormatBool(allowSendingWithoutReply)},
 			"reply_markup":                   {replyMarkup},
+		}, files)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending animation to telegram chat { Chat ID : %s, Animation : %s, "+
+			"Duration : %d, Width : %d, Height: %d, Thumb : %s, Caption : %s, Parse Mode : %s, Caption Entities : %s, "+
+			"Disable Content Type Detection : %v, Disable Notification : %v, Reply To Message ID : %d, "+
+			"Protect Content : %v, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, animation, duration, width, height, thumb, caption, parseMode, captionEntities,
+			disableContentTypeDetection, disableNotification, replyToMessageID, protectContent,
+			allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending animation to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Animation : %s, Duration : %d, Width : %d, Height: %d, Thumb : %s, Caption : %s, "+
+			"Parse Mode : %s, Caption Entities : %s, Disable Content Type Detection : %v, Disable Notification : %v, "+
+			"Reply To Message ID : %d, Protect Content : %v, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, animation, duration, width, height, thumb, caption, parseMode, captionEntities,
+			disableContentTypeDetection, disableNotification, replyToMessageID, protectContent,
+			allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending animation to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending animation to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SendAudioToTelegramChat sends an audio file to the Telegram chat identified by its chat ID
+/* The audio must be in the .MP3 or .M4A format for being displayed in the music player */
+/* The audio can be a file id or url string, or an *InputFile for uploading a new file */
+/* Available Optional Values */
+/* Caption                     string */
+/* ParseMode                   string -- 'html' if not provided */
+/* CaptionEntities             []MessageEntity */
+/* Duration                    int64 */
+/* Performer                   string */
+/* Title                       string */
+/* Thumb                       string or *InputFile */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendAudioToTelegramChat(chatID interface{}, audio interface{},
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendAudioToTelegramChatCtx(context.Background(), chatID, audio, optionals)
+}
+
+// SendAudioToTelegramChatCtx is the context aware version of SendAudioToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendAudioToTelegramChatCtx(ctx context.Context, chatID interface{},
+	audio interface{}, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	caption := ""
+	parseMode := ""
+	captionEntities := ""
+	performer := ""
+	title := ""
+	thumb := ""
+	replyMarkup := ""
+	chatIDS := ""
+
+	var duration int64
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	files := make(uploadFiles)
+	audioValue, err := files.add("audio", audio, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// If optionals are nil then set the default mode
+	if optionals == nil {
+		parseMode = "html"
+	} else {
+		if len(optionals.CaptionEntities) > 0 {
+			captionEntitiesByte, _ := json.Marshal(optionals.CaptionEntities)
+			captionEntities = string(captionEntitiesByte)
+		}
+
+		if optionals.ParseMode == "" {
+			parseMode = "html"
+		} else {
+			parseMode = optionals.ParseMode
+		}
+
+		caption = optionals.Caption
+		duration = optionals.Duration
+		performer = optionals.Performer
+		title = optionals.Title
+		if thumb, err = files.add("thumb", optionals.Thumb, true); err != nil {
+			return nil, err
+		}
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending audio to telegram chat { Chat ID : %s, Audio : %s, Caption : %s, "+
+		"Parse Mode : %s, Caption Entities : %s, Duration : %d, Performer : %s, Title : %s, Thumb : %s, "+
+		"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+		"Reply Markup : %s }",
+		chatIDS, audio, caption, parseMode, captionEntities, duration, performer, title, thumb, disableNotification,
+		protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendAudio"
+	response, err := handler.postMultipart(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"audio":                       {audioValue},
+			"caption":                     {caption},
+			"parse_mode":                  {parseMode},
+			"caption_entities":            {captionEntities},
+			"duration":                    {strconv.FormatInt(duration, 10)},
+			"performer":                   {performer},
+			"title":                       {title},
+			"thumb":                       {thumb},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                {replyMarkup},
+		}, files)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending audio to telegram chat { Chat ID : %s, Audio : %s, "+
+			"Caption : %s, Parse Mode : %s, Caption Entities : %s, Duration : %d, Performer : %s, Title : %s, "+
+			"Thumb : %s, Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, audio, caption, parseMode, captionEntities, duration, performer, title, thumb, disableNotification,
+			protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending audio to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Audio : %s, Caption : %s, Parse Mode : %s, Caption Entities : %s, Duration : %d, "+
+			"Performer : %s, Title : %s, Thumb : %s, Disable Notification : %v, Protect Content : %v, "+
+			"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, audio, caption, parseMode, captionEntities, duration, performer, title, thumb, disableNotification,
+			protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending audio to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending audio to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SendVoiceToTelegramChat sends a voice message to the Telegram chat identified by its chat ID
+/* The voice must be in the .OGG format encoded with OPUS for being displayed as a playable voice message */
+/* The voice can be a file id or url string, or an *InputFile for uploading a new file */
+/* Available Optional Values */
+/* Caption                     string */
+/* ParseMode                   string -- 'html' if not provided */
+/* CaptionEntities             []MessageEntity */
+/* Duration                    int64 */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendVoiceToTelegramChat(chatID interface{}, voice interface{},
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendVoiceToTelegramChatCtx(context.Background(), chatID, voice, optionals)
+}
+
+// SendVoiceToTelegramChatCtx is the context aware version of SendVoiceToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendVoiceToTelegramChatCtx(ctx context.Context, chatID interface{},
+	voice interface{}, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	caption := ""
+	parseMode := ""
+	captionEntities := ""
+	replyMarkup := ""
+	chatIDS := ""
+
+	var duration int64
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	files := make(uploadFiles)
+	voiceValue, err := files.add("voice", voice, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// If optionals are nil then set the default mode
+	if optionals == nil {
+		parseMode = "html"
+	} else {
+		if len(optionals.CaptionEntities) > 0 {
+			captionEntitiesByte, _ := json.Marshal(optionals.CaptionEntities)
+			captionEntities = string(captionEntitiesByte)
+		}
+
+		if optionals.ParseMode == "" {
+			parseMode = "html"
+		} else {
+			parseMode = optionals.ParseMode
+		}
+
+		caption = optionals.Caption
+		duration = optionals.Duration
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending voice to telegram chat { Chat ID : %s, Voice : %s, Caption : %s, "+
+		"Parse Mode : %s, Caption Entities : %s, Duration : %d, Disable Notification : %v, Protect Content : %v, "+
+		"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }",
+		chatIDS, voice, caption, parseMode, captionEntities, duration, disableNotification, protectContent,
+		replyToMessageID, allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendVoice"
+	response, err := handler.postMultipart(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"voice":                       {voiceValue},
+			"caption":                     {caption},
+			"parse_mode":                  {parseMode},
+			"caption_entities":            {captionEntities},
+			"duration":                    {strconv.FormatInt(duration, 10)},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                {replyMarkup},
+		}, files)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending voice to telegram chat { Chat ID : %s, Voice : %s, "+
+			"Caption : %s, Parse Mode : %s, Caption Entities : %s, Duration : %d, Disable Notification : %v, "+
+			"Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+			"Reply Markup : %s }, %s",
+			chatIDS, voice, caption, parseMode, captionEntities, duration, disableNotification, protectContent,
+			replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending voice to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Voice : %s, Caption : %s, Parse Mode : %s, Caption Entities : %s, Duration : %d, "+
+			"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, voice, caption, parseMode, captionEntities, duration, disableNotification, protectContent,
+			replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending voice to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending voice to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SendVideoNoteToTelegramChat sends a rounded square video message to the Telegram chat identified by its chat ID
+/* The video note can be a file id string or an *InputFile for uploading a new file, sending by url is not supported */
+/* Available Optional Values */
+/* Duration                    int64 */
+/* Length                      int64 */
+/* Thumb                       string or *InputFile */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendVideoNoteToTelegramChat(chatID interface{}, videoNote interface{},
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendVideoNoteToTelegramChatCtx(context.Background(), chatID, videoNote, optionals)
+}
+
+// SendVideoNoteToTelegramChatCtx is the context aware version of SendVideoNoteToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendVideoNoteToTelegramChatCtx(ctx context.Context, chatID interface{},
+	videoNote interface{}, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	thumb := ""
+	replyMarkup := ""
+	chatIDS := ""
+
+	var duration int64
+	var length int64
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	files := make(uploadFiles)
+	videoNoteValue, err := files.add("video_note", videoNote, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		duration = optionals.Duration
+		length = optionals.Length
+		if thumb, err = files.add("thumb", optionals.Thumb, true); err != nil {
+			return nil, err
+		}
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending video note to telegram chat { Chat ID : %s, Video Note : %s, "+
+		"Duration : %d, Length : %d, Thumb : %s, Disable Notification : %v, Protect Content : %v, "+
+		"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }",
+		chatIDS, videoNote, duration, length, thumb, disableNotification, protectContent, replyToMessageID,
+		allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendVideoNote"
+	response, err := handler.postMultipart(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"video_note":                  {videoNoteValue},
+			"duration":                    {strconv.FormatInt(duration, 10)},
+			"length":                      {strconv.FormatInt(length, 10)},
+			"thumb":                       {thumb},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                {replyMarkup},
+		}, files)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending video note to telegram chat { Chat ID : %s, Video Note : %s, "+
+			"Duration : %d, Length : %d, Thumb : %s, Disable Notification : %v, Protect Content : %v, "+
+			"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, videoNote, duration, length, thumb, disableNotification, protectContent, replyToMessageID,
+			allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending video note to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Video Note : %s, Duration : %d, Length : %d, Thumb : %s, Disable Notification : %v, "+
+			"Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+			"Reply Markup : %s }, %s",
+			chatIDS, videoNote, duration, length, thumb, disableNotification, protectContent, replyToMessageID,
+			allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending video note to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending video note to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SendMediaGroupToTelegramChat sends a group of photos, videos, documents or audio files as an album
+/* The media can include 2 to 10 InputMediaPhoto, InputMediaVideo, InputMediaDocument or InputMediaAudio values, */
+/* documents and audio files can't be mixed with other types. Their Media and Thumb can be an *InputFile for */
+/* uploading new files. On success, the sent messages are returned */
+/* Available Optional Values */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+func (handler *TelegramBotHandler) SendMediaGroupToTelegramChat(chatID interface{}, media []interface{},
+	optionals *entity.Optional) (*entity.MessagesResponse, error) {
+	return handler.SendMediaGroupToTelegramChatCtx(context.Background(), chatID, media, optionals)
+}
+
+// SendMediaGroupToTelegramChatCtx is the context aware version of SendMediaGroupToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendMediaGroupToTelegramChatCtx(ctx context.Context, chatID interface{},
+	media []interface{}, optionals *entity.Optional) (*entity.MessagesResponse, error) {
+
+	chatIDS := ""
+
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	if err := validateMediaGroup(media); err != nil {
+		return nil, err
+	}
+
+	files := make(uploadFiles)
+	attachedMedia := make([]interface{}, len(media))
+	for index, item := range media {
+		attachedItem, err := files.attachMedia(item)
+		if err != nil {
+			return nil, err
+		}
+		attachedMedia[index] = attachedItem
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+	}
+
+	mediaByte, _ := json.Marshal(attachedMedia)
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending media group to telegram chat { Chat ID : %s, Media : %s, "+
+		"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v }",
+		chatIDS, mediaByte, disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply),
+		log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendMediaGroup"
+	response, err := handler.postMultipart(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"media":                       {string(mediaByte)},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+		}, files)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending media group to telegram chat { Chat ID : %s, Media : %s, "+
+			"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v }, %s", chatIDS, mediaByte, disableNotification, protectContent,
+			replyToMessageID, allowSendingWithoutReply, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessagesResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending media group to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Media : %s, Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v }, %s", chatIDS, mediaByte, disableNotification, protectContent,
+			replyToMessageID, allowSendingWithoutReply, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending media group to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending media group to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SendLocationToTelegramChat sends a point on the map to the Telegram chat identified by its chat ID
+/* A live location that can be edited is sent if the live period is provided, it should be between 60 and 86400 seconds */
+/* Available Optional Values */
+/* HorizontalAccuracy          float64 */
+/* LivePeriod                  int64 */
+/* Heading                     int64 */
+/* ProximityAlertRadius        int64 */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendLocationToTelegramChat(chatID interface{}, latitude float64, longitude float64,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendLocationToTelegramChatCtx(context.Background(), chatID, latitude, longitude, optionals)
+}
+
+// SendLocationToTelegramChatCtx is the context aware version of SendLocationToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendLocationToTelegramChatCtx(ctx context.Context, chatID interface{},
+	latitude float64, longitude float64, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	replyMarkup := ""
+	chatIDS := ""
+
+	var horizontalAccuracy float64
+	var livePeriod int64
+	var heading int64
+	var proximityAlertRadius int64
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		horizontalAccuracy = optionals.HorizontalAccuracy
+		livePeriod = optionals.LivePeriod
+		heading = optionals.Heading
+		proximityAlertRadius = optionals.ProximityAlertRadius
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending location to telegram chat { Chat ID : %s, Latitude : %v, "+
+		"Longitude : %v, Horizontal Accuracy : %v, Live Period : %d, Heading : %d, Proximity Alert Radius : %d, "+
+		"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+		"Reply Markup : %s }",
+		chatIDS, latitude, longitude, horizontalAccuracy, livePeriod, heading, proximityAlertRadius,
+		disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	values := url.Values{
+		"chat_id":                     {chatIDS},
+		"latitude":                    {strconv.FormatFloat(latitude, 'f', -1, 64)},
+		"longitude":                   {strconv.FormatFloat(longitude, 'f', -1, 64)},
+		"disable_notification":        {strconv.FormatBool(disableNotification)},
+		"protect_content":             {strconv.FormatBool(protectContent)},
+		"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+		"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+		"reply_markup":                {replyMarkup},
+	}
+
+	if horizontalAccuracy > 0 {
+		values.Set("horizontal_accuracy", strconv.FormatFloat(horizontalAccuracy, 'f', -1, 64))
+	}
+	// The location is only sent as a live location when a live period is provided
+	if livePeriod > 0 {
+		values.Set("live_period", strconv.FormatInt(livePeriod, 10))
+	}
+	if heading > 0 {
+		values.Set("heading", strconv.FormatInt(heading, 10))
+	}
+	if proximityAlertRadius > 0 {
+		values.Set("proximity_alert_radius", strconv.FormatInt(proximityAlertRadius, 10))
+	}
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendLocation"
+	response, err := handler.postForm(ctx, telegramAPI, values)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending location to telegram chat { Chat ID : %s, Latitude : %v, "+
+			"Longitude : %v, Horizontal Accuracy : %v, Live Period : %d, Heading : %d, Proximity Alert Radius : %d, "+
+			"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, latitude, longitude, horizontalAccuracy, livePeriod, heading, proximityAlertRadius,
+			disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()),
+			log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending location to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Latitude : %v, Longitude : %v, Horizontal Accuracy : %v, Live Period : %d, Heading : %d, "+
+			"Proximity Alert Radius : %d, Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, latitude, longitude, horizontalAccuracy, livePeriod, heading, proximityAlertRadius,
+			disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()),
+			log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending location to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending location to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// EditLiveLocationToTelegramChat edits a live location sent to the Telegram chat identified by its (chat ID and message ID) or inline message id
+/* It is the equivalent of editMessageLiveLocation, the location can be edited until its live period expires or it is stopped */
+/* Only the location is required because (chat ID and message ID) or inline message id are interchangable, if one is available it works */
+/* Available Optional Values */
+/* ChatID                      interface{} */
+/* MessageID                   int64 */
+/* InlineMessageID             string */
+/* HorizontalAccuracy          float64 */
+/* Heading                     int64 */
+/* ProximityAlertRadius        int64 */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) EditLiveLocationToTelegramChat(latitude float64, longitude float64,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.EditLiveLocationToTelegramChatCtx(context.Background(), latitude, longitude, optionals)
+}
+
+// EditLiveLocationToTelegramChatCtx is the context aware version of EditLiveLocationToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) EditLiveLocationToTelegramChatCtx(ctx context.Context, latitude float64,
+	longitude float64, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	chatID := ""
+	inlineMessageID := ""
+	replyMarkup := ""
+
+	var messageID int64
+	var horizontalAccuracy float64
+	var heading int64
+	var proximityAlertRadius int64
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if id, ok := optionals.ChatID.(int64); ok {
+			chatID = strconv.FormatInt(id, 10)
+		} else if id, ok := optionals.ChatID.(string); ok {
+			chatID = id
+		} else if optionals.ChatID == nil {
+			// Since chatID can be empty
+			chatID = ""
+		} else {
+			return nil, errors.New("chat id can only be type string or integer")
+		}
+
+		messageID = optionals.MessageID
+		inlineMessageID = optionals.InlineMessageID
+		horizontalAccuracy = optionals.HorizontalAccuracy
+		heading = optionals.Heading
+		proximityAlertRadius = optionals.ProximityAlertRadius
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started editing live location sent to telegram chat { Chat ID : %s, Latitude : %v, "+
+		"Longitude : %v, Message ID : %d, Inline Message ID : %s, Horizontal Accuracy : %v, Heading : %d, "+
+		"Proximity Alert Radius : %d, Reply Markup : %s }",
+		chatID, latitude, longitude, messageID, inlineMessageID, horizontalAccuracy, heading, proximityAlertRadius,
+		replyMarkup), log.BotLogFile)
+
+	values := url.Values{
+		"chat_id":           {chatID},
+		"latitude":          {strconv.FormatFloat(latitude, 'f', -1, 64)},
+		"longitude":         {strconv.FormatFloat(longitude, 'f', -1, 64)},
+		"message_id":        {strconv.FormatInt(messageID, 10)},
+		"inline_message_id": {inlineMessageID},
+		"reply_markup":      {replyMarkup},
+	}
+
+	if horizontalAccuracy > 0 {
+		values.Set("horizontal_accuracy", strconv.FormatFloat(horizontalAccuracy, 'f', -1, 64))
+	}
+	if heading > 0 {
+		values.Set("heading", strconv.FormatInt(heading, 10))
+	}
+	if proximityAlertRadius > 0 {
+		values.Set("proximity_alert_radius", strconv.FormatInt(proximityAlertRadius, 10))
+	}
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/editMessageLiveLocation"
+	response, err := handler.postForm(ctx, telegramAPI, values)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing live location sent to telegram chat { Chat ID : %s, "+
+			"Latitude : %v, Longitude : %v, Message ID : %d, Inline Message ID : %s, Horizontal Accuracy : %v, "+
+			"Heading : %d, Proximity Alert Radius : %d, Reply Markup : %s }, %s",
+			chatID, latitude, longitude, messageID, inlineMessageID, horizontalAccuracy, heading, proximityAlertRadius,
+			replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing live location sent to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Latitude : %v, Longitude : %v, Message ID : %d, Inline Message ID : %s, "+
+			"Horizontal Accuracy : %v, Heading : %d, Proximity Alert Radius : %d, Reply Markup : %s }, %s",
+			chatID, latitude, longitude, messageID, inlineMessageID, horizontalAccuracy, heading, proximityAlertRadius,
+			replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing live location sent to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished editing live location sent to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// StopLiveLocationToTelegramChat stops updating a live location sent to the Telegram chat identified by its (chat ID and message ID) or inline message id
+/* It is the equivalent of stopMessageLiveLocation, (chat ID and message ID) or inline message id are interchangable, if one is available it works */
+/* Available Optional Values */
+/* ChatID                      interface{} */
+/* MessageID                   int64 */
+/* InlineMessageID             string */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) StopLiveLocationToTelegramChat(
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.StopLiveLocationToTelegramChatCtx(context.Background(), optionals)
+}
+
+// StopLiveLocationToTelegramChatCtx is the context aware version of StopLiveLocationToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) StopLiveLocationToTelegramChatCtx(ctx context.Context,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	chatID := ""
+	inlineMessageID := ""
+	replyMarkup := ""
+
+	var messageID int64
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if id, ok := optionals.ChatID.(int64); ok {
+			chatID = strconv.FormatInt(id, 10)
+		} else if id, ok := optionals.ChatID.(string); ok {
+			chatID = id
+		} else if optionals.ChatID == nil {
+			// Since chatID can be empty
+			chatID = ""
+		} else {
+			return nil, errors.New("chat id can only be type string or integer")
+		}
+
+		messageID = optionals.MessageID
+		inlineMessageID = optionals.InlineMessageID
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started stopping live location sent to telegram chat { Chat ID : %s, "+
+		"Message ID : %d, Inline Message ID : %s, Reply Markup : %s }",
+		chatID, messageID, inlineMessageID, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/stopMessageLiveLocation"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":           {chatID},
+			"message_id":        {strconv.FormatInt(messageID, 10)},
+			"inline_message_id": {inlineMessageID},
+			"reply_markup":      {replyMarkup},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For stopping live location sent to telegram chat { Chat ID : %s, "+
+			"Message ID : %d, Inline Message ID : %s, Reply Markup : %s }, %s",
+			chatID, messageID, inlineMessageID, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For stopping live location sent to telegram chat, unable to parse "+
+			"response { Chat ID : %s, Message ID : %d, Inline Message ID : %s, Reply Markup : %s }, %s",
+			chatID, messageID, inlineMessageID, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For stopping live location sent to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished stopping live location sent to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SendVenueToTelegramChat sends information about a venue to the Telegram chat identified by its chat ID
+/* Available Optional Values */
+/* FoursquareID                string */
+/* FoursquareType              string */
+/* GooglePlaceID               string */
+/* GooglePlaceType             string */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendVenueToTelegramChat(chatID interface{}, latitude float64, longitude float64,
+	title string, address string, optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendVenueToTelegramChatCtx(context.Background(), chatID, latitude, longitude, title, address, optionals)
+}
+
+// SendVenueToTelegramChatCtx is the context aware version of SendVenueToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendVenueToTelegramChatCtx(ctx context.Context, chatID interface{}, latitude float64,
+	longitude float64, title string, address string, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	foursquareID := ""
+	foursquareType := ""
+	googlePlaceID := ""
+	googlePlaceType := ""
+	replyMarkup := ""
+	chatIDS := ""
+
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		foursquareID = optionals.FoursquareID
+		foursquareType = optionals.FoursquareType
+		googlePlaceID = optionals.GooglePlaceID
+		googlePlaceType = optionals.GooglePlaceType
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending venue to telegram chat { Chat ID : %s, Latitude : %v, "+
+		"Longitude : %v, Title : %s, Address : %s, Foursquare ID : %s, Foursquare Type : %s, Google Place ID : %s, "+
+		"Google Place Type : %s, Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+		"Allow Sending Without Reply : %v, Reply Markup : %s }",
+		chatIDS, latitude, longitude, title, address, foursquareID, foursquareType, googlePlaceID, googlePlaceType,
+		disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendVenue"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"latitude":                    {strconv.FormatFloat(latitude, 'f', -1, 64)},
+			"longitude":                   {strconv.FormatFloat(longitude, 'f', -1, 64)},
+			"title":                       {title},
+			"address":                     {address},
+			"foursquare_id":               {foursquareID},
+			"foursquare_type":             {foursquareType},
+			"google_place_id":             {googlePlaceID},
+			"google_place_type":           {googlePlaceType},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                {replyMarkup},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending venue to telegram chat { Chat ID : %s, Latitude : %v, "+
+			"Longitude : %v, Title : %s, Address : %s, Foursquare ID : %s, Foursquare Type : %s, "+
+			"Google Place ID : %s, Google Place Type : %s, Disable Notification : %v, Protect Content : %v, "+
+			"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, latitude, longitude, title, address, foursquareID, foursquareType, googlePlaceID, googlePlaceType,
+			disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()),
+			log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending venue to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Latitude : %v, Longitude : %v, Title : %s, Address : %s, Foursquare ID : %s, "+
+			"Foursquare Type : %s, Google Place ID : %s, Google Place Type : %s, Disable Notification : %v, "+
+			"Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+			"Reply Markup : %s }, %s",
+			chatIDS, latitude, longitude, title, address, foursquareID, foursquareType, googlePlaceID, googlePlaceType,
+			disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()),
+			log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending venue to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending venue to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SendContactToTelegramChat sends a phone contact to the Telegram chat identified by its chat ID
+/* Available Optional Values */
+/* LastName                    string */
+/* VCard                       string */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendContactToTelegramChat(chatID interface{}, phoneNumber string, firstName string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendContactToTelegramChatCtx(context.Background(), chatID, phoneNumber, firstName, optionals)
+}
+
+// SendContactToTelegramChatCtx is the context aware version of SendContactToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendContactToTelegramChatCtx(ctx context.Context, chatID interface{},
+	phoneNumber string, firstName string, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	lastName := ""
+	vCard := ""
+	replyMarkup := ""
+	chatIDS := ""
+
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		lastName = optionals.LastName
+		vCard = optionals.VCard
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending contact to telegram chat { Chat ID : %s, Phone Number : %s, "+
+		"First Name : %s, Last Name : %s, VCard : %s, Disable Notification : %v, Protect Content : %v, "+
+		"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }",
+		chatIDS, phoneNumber, firstName, lastName, vCard, disableNotification, protectContent, replyToMessageID,
+		allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendContact"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"phone_number":                {phoneNumber},
+			"first_name":                  {firstName},
+			"last_name":                   {lastName},
+			"vcard":                       {vCard},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                {replyMarkup},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending contact to telegram chat { Chat ID : %s, Phone Number : %s, "+
+			"First Name : %s, Last Name : %s, VCard : %s, Disable Notification : %v, Protect Content : %v, "+
+			"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, phoneNumber, firstName, lastName, vCard, disableNotification, protectContent, replyToMessageID,
+			allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending contact to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Phone Number : %s, First Name : %s, Last Name : %s, VCard : %s, "+
+			"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, phoneNumber, firstName, lastName, vCard, disableNotification, protectContent, replyToMessageID,
+			allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending contact to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending contact to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SendDiceToTelegramChat sends an animated emoji that will display a random value to the Telegram chat identified by its chat ID
+/* The emoji can be one of the 'DiceEmoji' constants, '🎲' is used if not provided */
+/* Available Optional Values */
+/* Emoji                       string */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendDiceToTelegramChat(chatID interface{},
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendDiceToTelegramChatCtx(context.Background(), chatID, optionals)
+}
+
+// SendDiceToTelegramChatCtx is the context aware version of SendDiceToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendDiceToTelegramChatCtx(ctx context.Context, chatID interface{},
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	emoji := ""
+	replyMarkup := ""
+	chatIDS := ""
+
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		emoji = optionals.Emoji
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending dice to telegram chat { Chat ID : %s, Emoji : %s, "+
+		"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+		"Reply Markup : %s }",
+		chatIDS, emoji, disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup),
+		log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendDice"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"emoji":                       {emoji},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                {replyMarkup},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending dice to telegram chat { Chat ID : %s, Emoji : %s, "+
+			"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, emoji, disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply,
+			replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending dice to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Emoji : %s, Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, emoji, disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply,
+			replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending dice to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending dice to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SendPollToTelegramChat sends a native poll to the Telegram chat identified by its chat ID
+/* The poll should have 2 to 10 options, polls are anonymous unless NonAnonymous is set */
+/* For a quiz, set the poll type to 'quiz' and provide the 0-based identifier of the correct option */
+/* Open period and close date can not be used together */
+/* Available Optional Values */
+/* NonAnonymous                bool */
+/* PollType                    string */
+/* AllowsMultipleAnswers       bool */
+/* CorrectOptionID             int64 -- only sent for quiz polls */
+/* Explanation                 string */
+/* ExplanationParseMode        string */
+/* ExplanationEntities         []MessageEntity */
+/* OpenPeriod                  int64 */
+/* CloseDate                   int64 */
+/* IsClosed                    bool */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendPollToTelegramChat(chatID interface{}, question string, options []string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendPollToTelegramChatCtx(context.Background(), chatID, question, options, optionals)
+}
+
+// SendPollToTelegramChatCtx is the context aware version of SendPollToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendPollToTelegramChatCtx(ctx context.Context, chatID interface{}, question string,
+	options []string, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	pollType := ""
+	explanation := ""
+	explanationParseMode := ""
+	explanationEntities := ""
+	replyMarkup := ""
+	chatIDS := ""
+
+	var nonAnonymous bool
+	var allowsMultipleAnswers bool
+	var correctOptionID int64
+	var openPeriod int64
+	var closeDate int64
+	var isClosed bool
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if len(optionals.ExplanationEntities) > 0 {
+			explanationEntitiesByte, _ := json.Marshal(optionals.ExplanationEntities)
+			explanationEntities = string(explanationEntitiesByte)
+		}
+
+		nonAnonymous = optionals.NonAnonymous
+		pollType = optionals.PollType
+		allowsMultipleAnswers = optionals.AllowsMultipleAnswers
+		correctOptionID = optionals.CorrectOptionID
+		explanation = optionals.Explanation
+		explanationParseMode = optionals.ExplanationParseMode
+		openPeriod = optionals.OpenPeriod
+		closeDate = optionals.CloseDate
+		isClosed = optionals.IsClosed
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	optionsByte, _ := json.Marshal(options)
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending poll to telegram chat { Chat ID : %s, Question : %s, Options : %s, "+
+		"Non Anonymous : %v, Poll Type : %s, Allows Multiple Answers : %v, Correct Option ID : %d, Explanation : %s, "+
+		"Explanation Parse Mode : %s, Explanation Entities : %s, Open Period : %d, Close Date : %d, Is Closed : %v, "+
+		"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+		"Reply Markup : %s }",
+		chatIDS, question, optionsByte, nonAnonymous, pollType, allowsMultipleAnswers, correctOptionID, explanation,
+		explanationParseMode, explanationEntities, openPeriod, closeDate, isClosed, disableNotification, protectContent,
+		replyToMessageID, allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	values := url.Values{
+		"chat_id":                     {chatIDS},
+		"question":                    {question},
+		"options":                     {string(optionsByte)},
+		"is_anonymous":                {strconv.FormatBool(!nonAnonymous)},
+		"type":                        {pollType},
+		"allows_multiple_answers":     {strconv.FormatBool(allowsMultipleAnswers)},
+		"explanation":                 {explanation},
+		"explanation_parse_mode":      {explanationParseMode},
+		"explanation_entities":        {explanationEntities},
+		"is_closed":                   {strconv.FormatBool(isClosed)},
+		"disable_notification":        {strconv.FormatBool(disableNotification)},
+		"protect_content":             {strconv.FormatBool(protectContent)},
+		"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+		"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+		"reply_markup":                {replyMarkup},
+	}
+
+	// The correct option is only sent for quizzes, since zero is a valid option id
+	if pollType == entity.PollTypeQuiz {
+		values.Set("correct_option_id", strconv.FormatInt(correctOptionID, 10))
+	}
+
+	// Telegram doesn't accept an open period together with a close date, so neither is sent unless provided
+	if openPeriod > 0 {
+		values.Set("open_period", strconv.FormatInt(openPeriod, 10))
+	}
+	if closeDate > 0 {
+		values.Set("close_date", strconv.FormatInt(closeDate, 10))
+	}
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendPoll"
+	response, err := handler.postForm(ctx, telegramAPI, values)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending poll to telegram chat { Chat ID : %s, Question : %s, "+
+			"Options : %s, Non Anonymous : %v, Poll Type : %s, Allows Multiple Answers : %v, Correct Option ID : %d, "+
+			"Explanation : %s, Explanation Parse Mode : %s, Explanation Entities : %s, Open Period : %d, "+
+			"Close Date : %d, Is Closed : %v, Disable Notification : %v, Protect Content : %v, "+
+			"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, question, optionsByte, nonAnonymous, pollType, allowsMultipleAnswers, correctOptionID, explanation,
+			explanationParseMode, explanationEntities, openPeriod, closeDate, isClosed, disableNotification,
+			protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending poll to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Question : %s, Options : %s, Non Anonymous : %v, Poll Type : %s, "+
+			"Allows Multiple Answers : %v, Correct Option ID : %d, Explanation : %s, Explanation Parse Mode : %s, "+
+			"Explanation Entities : %s, Open Period : %d, Close Date : %d, Is Closed : %v, Disable Notification : %v, "+
+			"Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+			"Reply Markup : %s }, %s",
+			chatIDS, question, optionsByte, nonAnonymous, pollType, allowsMultipleAnswers, correctOptionID, explanation,
+			explanationParseMode, explanationEntities, openPeriod, closeDate, isClosed, disableNotification,
+			protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending poll to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending poll to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// StopPollToTelegramChat stops a poll sent by the bot to the Telegram chat identified by its chat ID
+/* On success, the stopped poll with the final results is returned */
+/* Available Optional Values */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) StopPollToTelegramChat(chatID interface{}, messageID int64,
+	optionals *entity.Optional) (*entity.PollResponse, error) {
+	return handler.StopPollToTelegramChatCtx(context.Background(), chatID, messageID, optionals)
+}
+
+// StopPollToTelegramChatCtx is the context aware version of StopPollToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) StopPollToTelegramChatCtx(ctx context.Context, chatID interface{}, messageID int64,
+	optionals *entity.Optional) (*entity.PollResponse, error) {
+
+	replyMarkup := ""
+	chatIDS := ""
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started stopping poll sent to telegram chat { Chat ID : %s, Message ID : %d, "+
+		"Reply Markup : %s }",
+		chatIDS, messageID, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/stopPoll"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":      {chatIDS},
+			"message_id":   {strconv.FormatInt(messageID, 10)},
+			"reply_markup": {replyMarkup},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For stopping poll sent to telegram chat { Chat ID : %s, Message ID : %d, "+
+			"Reply Markup : %s }, %s",
+			chatIDS, messageID, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.PollResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For stopping poll sent to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Message ID : %d, Reply Markup : %s }, %s",
+			chatIDS, messageID, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For stopping poll sent to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished stopping poll sent to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// ForwardMessageToTelegramChat forwards a message of any kind to the Telegram chat identified by its chat ID
+/* The forwarded message keeps a link to the original message, use CopyMessageToTelegramChat for sending it without the link */
+/* Available Optional Values */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+func (handler *TelegramBotHandler) ForwardMessageToTelegramChat(chatID interface{}, fromChatID interface{},
+	messageID int64, optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.ForwardMessageToTelegramChatCtx(context.Background(), chatID, fromChatID, messageID, optionals)
+}
+
+// ForwardMessageToTelegramChatCtx is the context aware version of ForwardMessageToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) ForwardMessageToTelegramChatCtx(ctx context.Context, chatID interface{},
+	fromChatID interface{}, messageID int64, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	chatIDS := ""
+
+	var disableNotification bool
+	var protectContent bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	fromChatIDS := ""
+	if id, ok := fromChatID.(int64); ok {
+		fromChatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := fromChatID.(string); ok {
+		fromChatIDS = id
+	} else {
+		return nil, errors.New("from chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started forwarding message to telegram chat { Chat ID : %s, From Chat ID : %s, "+
+		"Message ID : %d, Disable Notification : %v, Protect Content : %v }",
+		chatIDS, fromChatIDS, messageID, disableNotification, protectContent), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/forwardMessage"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":              {chatIDS},
+			"from_chat_id":         {fromChatIDS},
+			"message_id":           {strconv.FormatInt(messageID, 10)},
+			"disable_notification": {strconv.FormatBool(disableNotification)},
+			"protect_content":      {strconv.FormatBool(protectContent)},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For forwarding message to telegram chat { Chat ID : %s, "+
+			"From Chat ID : %s, Message ID : %d, Disable Notification : %v, Protect Content : %v }, %s",
+			chatIDS, fromChatIDS, messageID, disableNotification, protectContent, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For forwarding message to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, From Chat ID : %s, Message ID : %d, Disable Notification : %v, "+
+			"Protect Content : %v }, %s",
+			chatIDS, fromChatIDS, messageID, disableNotification, protectContent, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For forwarding message to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished forwarding message to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// CopyMessageToTelegramChat copies a message of any kind to the Telegram chat identified by its chat ID
+/* The copy doesn't have a link to the original message, and its caption can be replaced by providing a new caption */
+/* Service messages and invoice messages can not be copied. On success, the id of the sent message is returned */
+/* Available Optional Values */
+/* Caption                     string */
+/* ParseMode                   string */
+/* CaptionEntities             []MessageEntity */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) CopyMessageToTelegramChat(chatID interface{}, fromChatID interface{},
+	messageID int64, optionals *entity.Optional) (*entity.MessageIDResponse, error) {
+	return handler.CopyMessageToTelegramChatCtx(context.Background(), chatID, fromChatID, messageID, optionals)
+}
+
+// CopyMessageToTelegramChatCtx is the context aware version of CopyMessageToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) CopyMessageToTelegramChatCtx(ctx context.Context, chatID interface{},
+	fromChatID interface{}, messageID int64, optionals *entity.Optional) (*entity.MessageIDResponse, error) {
+
+	caption := ""
+	parseMode := ""
+	captionEntities := ""
+	replyMarkup := ""
+	chatIDS := ""
+
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	fromChatIDS := ""
+	if id, ok := fromChatID.(int64); ok {
+		fromChatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := fromChatID.(string); ok {
+		fromChatIDS = id
+	} else {
+		return nil, errors.New("from chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if len(optionals.CaptionEntities) > 0 {
+			captionEntitiesByte, _ := json.Marshal(optionals.CaptionEntities)
+			captionEntities = string(captionEntitiesByte)
+		}
+
+		caption = optionals.Caption
+		parseMode = optionals.ParseMode
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started copying message to telegram chat { Chat ID : %s, From Chat ID : %s, "+
+		"Message ID : %d, Caption : %s, Parse Mode : %s, Caption Entities : %s, Disable Notification : %v, "+
+		"Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }",
+		chatIDS, fromChatIDS, messageID, caption, parseMode, captionEntities, disableNotification, protectContent,
+		replyToMessageID, allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/copyMessage"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"from_chat_id":                {fromChatIDS},
+			"message_id":                  {strconv.FormatInt(messageID, 10)},
+			"caption":                     {caption},
+			"parse_mode":                  {parseMode},
+			"caption_entities":            {captionEntities},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                {replyMarkup},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For copying message to telegram chat { Chat ID : %s, From Chat ID : %s, "+
+			"Message ID : %d, Caption : %s, Parse Mode : %s, Caption Entities : %s, Disable Notification : %v, "+
+			"Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+			"Reply Markup : %s }, %s",
+			chatIDS, fromChatIDS, messageID, caption, parseMode, captionEntities, disableNotification, protectContent,
+			replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageIDResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For copying message to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, From Chat ID : %s, Message ID : %d, Caption : %s, Parse Mode : %s, "+
+			"Caption Entities : %s, Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, fromChatIDS, messageID, caption, parseMode, captionEntities, disableNotification, protectContent,
+			replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For copying message to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished copying message to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// EditMediaToTelegramChat edits a reply sent to the Telegram chat identified by its (chat ID and message ID) or inline message id
+/* Only text is required because (chat ID and message ID) or inline message id are interchangable, if one is available it works */
+/* The media can be any of the InputMedia types, its Media and Thumb can be an *InputFile for uploading new files */
+/* Available Optional Values */
+/* ChatID                   interface{} */
+/* MessageID                int64 */
+/* InlineMessageID          string */
+/* ReplyMarkup              string */
+func (handler *TelegramBotHandler) EditMediaToTelegramChat(media interface{},
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.EditMediaToTelegramChatCtx(context.Background(), media, optionals)
+}
+
+// EditMediaToTelegramChatCtx is the context aware version of EditMediaToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) EditMediaToTelegramChatCtx(ctx context.Context, media interface{},
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	chatID := ""
+	messageID := optionals.MessageID
+	inlineMessageID := optionals.InlineMessageID
+	replyMarkup := optionals.ReplyMarkup
+
+	if id, ok := optionals.ChatID.(int64); ok {
+		chatID = strconv.FormatInt(id, 10)
+	} else if id, ok := optionals.ChatID.(string); ok {
+		chatID = id
+	} else if optionals.ChatID == nil {
+		// Since chatID can be empty
+		chatID = ""
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	files := make(uploadFiles)
+	attachedMedia, err := files.attachMedia(media)
+	if err != nil {
+		return nil, err
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started editing media reply sent to telegram chat { Chat ID : %s, Message ID : %d, "+
+		"Inline Message ID : %s, Media : %s, Reply Markup : %s }", chatID, messageID, inlineMessageID, media,
+		replyMarkup), log.BotLogFile)
+
+	mediaByte, _ := json.MarshalIndent(attachedMedia, "", "	")
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/editMessageMedia"
+	response, err := handler.postMultipart(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":           {chatID},
+			"message_id":        {strconv.FormatInt(messageID, 10)},
+			"inline_message_id": {inlineMessageID},
+			"media":             {string(mediaByte)},
+			"reply_markup":      {replyMarkup},
+		}, files)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing media reply sent to telegram chat { Chat ID : %s, Message ID : %d, "+
+			"Inline Message ID : %s, Media : %s, Reply Markup : %s }, %s", chatID, messageID, inlineMessageID, media,
+			replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing media reply sent to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Message ID : %d, Inline Message ID : %s, Media : %s, Reply Markup : %s }, %s",
+			chatID, messageID, inlineMessageID, media, replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing media reply sent to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished editing media reply sent to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// DeleteMessageFromTelegramChat deletes a message from the Telegram chat identified by its chat ID
+/* A message can only be deleted if it was sent less than 48 hours ago, bots can delete outgoing messages in */
+/* private chats, groups and supergroups, and any message in chats where they have the can_delete_messages right */
+func (handler *TelegramBotHandler) DeleteMessageFromTelegramChat(chatID interface{},
+	messageID int64) (*entity.ChatDefaultResponse, error) {
+	return handler.DeleteMessageFromTelegramChatCtx(context.Background(), chatID, messageID)
+}
+
+// DeleteMessageFromTelegramChatCtx is the context aware version of DeleteMessageFromTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) DeleteMessageFromTelegramChatCtx(ctx context.Context, chatID interface{},
+	messageID int64) (*entity.ChatDefaultResponse, error) {
+
+	chatIDS := ""
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started deleting message from telegram chat { Chat ID : %s, Message ID : %d }",
+		chatIDS, messageID), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/deleteMessage"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":    {chatIDS},
+			"message_id": {strconv.FormatInt(messageID, 10)},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For deleting message from telegram chat { Chat ID : %s, Message ID : %d }, %s",
+			chatIDS, messageID, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.ChatDefaultResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For deleting message from telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Message ID : %d }, %s",
+			chatIDS, messageID, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For deleting message from telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished deleting message from telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// EditCaptionToTelegramChat edits the caption of a message sent to the Telegram chat identified by its (chat ID and message ID) or inline message id
+/* Only caption is required because (chat ID and message ID) or inline message id are interchangable, if one is available it works */
+/* An empty caption removes the caption of the message */
+/* Available Optional Values */
+/* ChatID                      interface{} */
+/* MessageID                   int64 */
+/* InlineMessageID             string */
+/* ParseMode                   string -- 'html' if not provided */
+/* CaptionEntities             []MessageEntity */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) EditCaptionToTelegramChat(caption string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.EditCaptionToTelegramChatCtx(context.Background(), caption, optionals)
+}
+
+// EditCaptionToTelegramChatCtx is the context aware version of EditCaptionToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) EditCaptionToTelegramChatCtx(ctx context.Context, caption string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	chatID := ""
+	inlineMessageID := ""
+	parseMode := ""
+	captionEntities := ""
+	replyMarkup := ""
+
+	var messageID int64
+
+	// If optionals are nil then set the default mode
+	if optionals == nil {
+		parseMode = "html"
+	} else {
+		if id, ok := optionals.ChatID.(int64); ok {
+			chatID = strconv.FormatInt(id, 10)
+		} else if id, ok := optionals.ChatID.(string); ok {
+			chatID = id
+		} else if optionals.ChatID == nil {
+			// Since chatID can be empty
+			chatID = ""
+		} else {
+			return nil, errors.New("chat id can only be type string or integer")
+		}
+
+		if len(optionals.CaptionEntities) > 0 {
+			captionEntitiesByte, _ := json.Marshal(optionals.CaptionEntities)
+			captionEntities = string(captionEntitiesByte)
+		}
+
+		if optionals.ParseMode == "" {
+			parseMode = "html"
+		} else {
+			parseMode = optionals.ParseMode
+		}
+
+		messageID = optionals.MessageID
+		inlineMessageID = optionals.InlineMessageID
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started editing caption sent to telegram chat { Chat ID : %s, Caption : %s, "+
+		"Message ID : %d, Inline Message ID : %s, Parse Mode : %s, Caption Entities : %s, Reply Markup : %s }",
+		chatID, caption, messageID, inlineMessageID, parseMode, captionEntities, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/editMessageCaption"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":           {chatID},
+			"caption":           {caption},
+			"message_id":        {strconv.FormatInt(messageID, 10)},
+			"inline_message_id": {inlineMessageID},
+			"parse_mode":        {parseMode},
+			"caption_entities":  {captionEntities},
+			"reply_markup":      {replyMarkup},
 		})
 
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
-		handler.Logging(fmt.Sprintf("Error: For sending animation to telegram chat { Chat ID : %s, Animation : %s, "+
-			"Duration : %d, Width : %d, Height: %d, Thumb : %s, Caption : %s, Parse Mode : %s, Caption Entities : %s, "+
-			"Disable Content Type Detection : %v, Disable Notification : %v, Reply To Message ID : %d, "+
-			"Protect Content : %v, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
-			chatIDS, animation, duration, width, height, thumb, caption, parseMode, captionEntities,
-			disableContentTypeDetection, disableNotification, replyToMessageID, protectContent,
-			allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+		handler.Logging(fmt.Sprintf("Error: For editing caption sent to telegram chat { Chat ID : %s, Caption : %s, "+
+			"Message ID : %d, Inline Message ID : %s, Parse Mode : %s, Caption Entities : %s, Reply Markup : %s }, %s",
+			chatID, caption, messageID, inlineMessageID, parseMode, captionEntities, replyMarkup, err.Error()),
+			log.ErrorLogFile)
 
 		return nil, err
 	}
@@ -580,72 +2789,89 @@ func (handler *TelegramBotHandler) SendAnimationToTelegramChat(chatID interface{
 	err = json.NewDecoder(response.Body).Decode(botResponse)
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
-		handler.Logging(fmt.Sprintf("Error: For sending animation to telegram chat, unable to parse response "+
-			"{ Chat ID : %s, Animation : %s, Duration : %d, Width : %d, Height: %d, Thumb : %s, Caption : %s, "+
-			"Parse Mode : %s, Caption Entities : %s, Disable Content Type Detection : %v, Disable Notification : %v, "+
-			"Reply To Message ID : %d, Protect Content : %v, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
-			chatIDS, animation, duration, width, height, thumb, caption, parseMode, captionEntities,
-			disableContentTypeDetection, disableNotification, replyToMessageID, protectContent,
-			allowSendingWithoutReply, replyMarkup, err.Error()), log.ErrorLogFile)
+		handler.Logging(fmt.Sprintf("Error: For editing caption sent to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Caption : %s, Message ID : %d, Inline Message ID : %s, Parse Mode : %s, "+
+			"Caption Entities : %s, Reply Markup : %s }, %s",
+			chatID, caption, messageID, inlineMessageID, parseMode, captionEntities, replyMarkup, err.Error()),
+			log.ErrorLogFile)
 
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing caption sent to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
-	handler.Logging(fmt.Sprintf("Finished sending animation to telegram chat, Bot Response => %s",
+	handler.Logging(fmt.Sprintf("Finished editing caption sent to telegram chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
 
 	return botResponse, nil
 }
 
-// EditMediaToTelegramChat edits a reply sent to the Telegram chat identified by its (chat ID and message ID) or inline message id
-/* Only text is required because (chat ID and message ID) or inline message id are interchangable, if one is available it works */
+// EditReplyMarkupToTelegramChat edits the inline keyboard of a message sent to the Telegram chat identified by its (chat ID and message ID) or inline message id
+/* Only reply markup is required because (chat ID and message ID) or inline message id are interchangable, if one is available it works */
+/* An empty reply markup removes the inline keyboard of the message */
 /* Available Optional Values */
-/* ChatID                   interface{} */
-/* MessageID                int64 */
-/* InlineMessageID          string */
-/* ReplyMarkup              string */
-func (handler *TelegramBotHandler) EditMediaToTelegramChat(media interface{},
+/* ChatID                      interface{} */
+/* MessageID                   int64 */
+/* InlineMessageID             string */
+func (handler *TelegramBotHandler) EditReplyMarkupToTelegramChat(replyMarkup string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.EditReplyMarkupToTelegramChatCtx(context.Background(), replyMarkup, optionals)
+}
+
+// EditReplyMarkupToTelegramChatCtx is the context aware version of EditReplyMarkupToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) EditReplyMarkupToTelegramChatCtx(ctx context.Context, replyMarkup string,
 	optionals *entity.Optional) (*entity.MessageResponse, error) {
 
 	chatID := ""
-	messageID := optionals.MessageID
-	inlineMessageID := optionals.InlineMessageID
-	replyMarkup := optionals.ReplyMarkup
+	inlineMessageID := ""
 
-	if id, ok := optionals.ChatID.(int64); ok {
-		chatID = strconv.FormatInt(id, 10)
-	} else if id, ok := optionals.ChatID.(string); ok {
-		chatID = id
-	} else if optionals.ChatID == nil {
-		// Since chatID can be empty
-		chatID = ""
-	} else {
-		return nil, errors.New("chat id can only be type string or integer")
+	var messageID int64
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if id, ok := optionals.ChatID.(int64); ok {
+			chatID = strconv.FormatInt(id, 10)
+		} else if id, ok := optionals.ChatID.(string); ok {
+			chatID = id
+		} else if optionals.ChatID == nil {
+			// Since chatID can be empty
+			chatID = ""
+		} else {
+			return nil, errors.New("chat id can only be type string or integer")
+		}
+
+		messageID = optionals.MessageID
+		inlineMessageID = optionals.InlineMessageID
 	}
 
 	/* ---------------------------- Logging ---------------------------- */
-	handler.Logging(fmt.Sprintf("Started editing media reply sent to telegram chat { Chat ID : %s, Message ID : %d, "+
-		"Inline Message ID : %s, Media : %s, Reply Markup : %s }", chatID, messageID, inlineMessageID, media,
-		replyMarkup), log.BotLogFile)
+	handler.Logging(fmt.Sprintf("Started editing reply markup sent to telegram chat { Chat ID : %s, "+
+		"Reply Markup : %s, Message ID : %d, Inline Message ID : %s }",
+		chatID, replyMarkup, messageID, inlineMessageID), log.BotLogFile)
 
-	mediaByte, _ := json.MarshalIndent(media, "", "	")
-	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/editMessageMedia"
-	response, err := http.PostForm(
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/editMessageReplyMarkup"
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":           {chatID},
+			"reply_markup":      {replyMarkup},
 			"message_id":        {strconv.FormatInt(messageID, 10)},
 			"inline_message_id": {inlineMessageID},
-			"media":             {string(mediaByte)},
-			"reply_markup":      {replyMarkup},
 		})
 
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
-		handler.Logging(fmt.Sprintf("Error: For editing media reply sent to telegram chat { Chat ID : %s, Message ID : %d, "+
-			"Inline Message ID : %s, Media : %s, Reply Markup : %s }, %s", chatID, messageID, inlineMessageID, media,
-			replyMarkup, err.Error()), log.ErrorLogFile)
+		handler.Logging(fmt.Sprintf("Error: For editing reply markup sent to telegram chat { Chat ID : %s, "+
+			"Reply Markup : %s, Message ID : %d, Inline Message ID : %s }, %s",
+			chatID, replyMarkup, messageID, inlineMessageID, err.Error()), log.ErrorLogFile)
 
 		return nil, err
 	}
@@ -655,15 +2881,23 @@ func (handler *TelegramBotHandler) EditMediaToTelegramChat(media interface{},
 	err = json.NewDecoder(response.Body).Decode(botResponse)
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
-		handler.Logging(fmt.Sprintf("Error: For editing media reply sent to telegram chat, unable to parse response "+
-			"{ Chat ID : %s, Message ID : %d, Inline Message ID : %s, Media : %s, Reply Markup : %s }, %s",
-			chatID, messageID, inlineMessageID, media, replyMarkup, err.Error()), log.ErrorLogFile)
+		handler.Logging(fmt.Sprintf("Error: For editing reply markup sent to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Reply Markup : %s, Message ID : %d, Inline Message ID : %s }, %s",
+			chatID, replyMarkup, messageID, inlineMessageID, err.Error()), log.ErrorLogFile)
 
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing reply markup sent to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
-	handler.Logging(fmt.Sprintf("Finished editing media reply sent to telegram chat, Bot Response => %s",
+	handler.Logging(fmt.Sprintf("Finished editing reply markup sent to telegram chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
 
 	return botResponse, nil
@@ -671,6 +2905,12 @@ func (handler *TelegramBotHandler) EditMediaToTelegramChat(media interface{},
 
 // GetChat gets  up to date information about the chat. Returns a Chat object on success.
 func (handler *TelegramBotHandler) GetChat(chatID interface{}) (*entity.ChatResponse, error) {
+	return handler.GetChatCtx(context.Background(), chatID)
+}
+
+// GetChatCtx is the context aware version of GetChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) GetChatCtx(ctx context.Context, chatID interface{}) (*entity.ChatResponse, error) {
 
 	chatIDS := ""
 	if id, ok := chatID.(int64); ok {
@@ -685,7 +2925,7 @@ func (handler *TelegramBotHandler) GetChat(chatID interface{}) (*entity.ChatResp
 	handler.Logging(fmt.Sprintf("Started getting chat { Chat ID : %s }", chatIDS), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/getChat?chat_id=" + chatIDS
-	response, err := http.Get(telegramAPI)
+	response, err := handler.get(ctx, telegramAPI)
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
 		handler.Logging(fmt.Sprintf("Error: For getting chat { Chat ID : %s }, %s",
@@ -705,6 +2945,14 @@ func (handler *TelegramBotHandler) GetChat(chatID interface{}) (*entity.ChatResp
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished getting chat, Bot Response => %s", botResponse.ToString()), log.BotLogFile)
 
@@ -713,6 +2961,13 @@ func (handler *TelegramBotHandler) GetChat(chatID interface{}) (*entity.ChatResp
 
 // GetChatMembers gets information about a member of a chat. Returns a ChatMember object on success.
 func (handler *TelegramBotHandler) GetChatMembers(chatID interface{}, userID int64) (*entity.ChatMemberResponse, error) {
+	return handler.GetChatMembersCtx(context.Background(), chatID, userID)
+}
+
+// GetChatMembersCtx is the context aware version of GetChatMembers
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) GetChatMembersCtx(ctx context.Context, chatID interface{},
+	userID int64) (*entity.ChatMemberResponse, error) {
 
 	chatIDS := ""
 	if id, ok := chatID.(int64); ok {
@@ -729,7 +2984,7 @@ func (handler *TelegramBotHandler) GetChatMembers(chatID interface{}, userID int
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/getChatMember?" +
 		fmt.Sprintf("chat_id=%s&user_id=%d", chatIDS, userID)
-	response, err := http.Get(telegramAPI)
+	response, err := handler.get(ctx, telegramAPI)
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
 		handler.Logging(fmt.Sprintf("Error: For getting chat members { Chat ID : %s, User ID : %d }, %s",
@@ -749,6 +3004,14 @@ func (handler *TelegramBotHandler) GetChatMembers(chatID interface{}, userID int
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting chat members, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished getting chat members, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -758,6 +3021,13 @@ func (handler *TelegramBotHandler) GetChatMembers(chatID interface{}, userID int
 
 // GetChatAdministrators gets a list of administrators in a chat
 func (handler *TelegramBotHandler) GetChatAdministrators(chatID interface{}) (*entity.ChatMembersResponse, error) {
+	return handler.GetChatAdministratorsCtx(context.Background(), chatID)
+}
+
+// GetChatAdministratorsCtx is the context aware version of GetChatAdministrators
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) GetChatAdministratorsCtx(ctx context.Context,
+	chatID interface{}) (*entity.ChatMembersResponse, error) {
 
 	chatIDS := ""
 	if id, ok := chatID.(int64); ok {
@@ -774,7 +3044,7 @@ func (handler *TelegramBotHandler) GetChatAdministrators(chatID interface{}) (*e
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/getChatAdministrators?" +
 		fmt.Sprintf("chat_id = %s", chatIDS)
-	response, err := http.Get(telegramAPI)
+	response, err := handler.get(ctx, telegramAPI)
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
 		handler.Logging(fmt.Sprintf("Error: For getting chat administrators { Chat ID : %s }, %s",
@@ -794,6 +3064,14 @@ func (handler *TelegramBotHandler) GetChatAdministrators(chatID interface{}) (*e
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting chat administrators, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished getting chat administrators, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -803,6 +3081,13 @@ func (handler *TelegramBotHandler) GetChatAdministrators(chatID interface{}) (*e
 
 // ExportChatInviteLink generates a new primary invite link for a chat.
 func (handler *TelegramBotHandler) ExportChatInviteLink(chatID interface{}) (*entity.ChatDefaultResponse, error) {
+	return handler.ExportChatInviteLinkCtx(context.Background(), chatID)
+}
+
+// ExportChatInviteLinkCtx is the context aware version of ExportChatInviteLink
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) ExportChatInviteLinkCtx(ctx context.Context,
+	chatID interface{}) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 	if id, ok := chatID.(int64); ok {
@@ -817,7 +3102,7 @@ func (handler *TelegramBotHandler) ExportChatInviteLink(chatID interface{}) (*en
 	handler.Logging(fmt.Sprintf("Started exporting chat invite link { Chat ID : %s }", chatIDS), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/exportChatInviteLink"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id": {chatIDS},
@@ -842,6 +3127,14 @@ func (handler *TelegramBotHandler) ExportChatInviteLink(chatID interface{}) (*en
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For exporting chat invite link, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished exporting chat invite link, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -857,6 +3150,13 @@ func (handler *TelegramBotHandler) ExportChatInviteLink(chatID interface{}) (*en
 /* CreateJoinRequest      bool */
 func (handler *TelegramBotHandler) CreateChatInviteLink(chatID interface{},
 	optionals *entity.Optional) (*entity.ChatInviteLinkResponse, error) {
+	return handler.CreateChatInviteLinkCtx(context.Background(), chatID, optionals)
+}
+
+// CreateChatInviteLinkCtx is the context aware version of CreateChatInviteLink
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) CreateChatInviteLinkCtx(ctx context.Context, chatID interface{},
+	optionals *entity.Optional) (*entity.ChatInviteLinkResponse, error) {
 
 	chatIDS := ""
 
@@ -887,7 +3187,7 @@ func (handler *TelegramBotHandler) CreateChatInviteLink(chatID interface{},
 		chatIDS, name, expireDate, memberLimit, createsJoinRequest), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/createChatInviteLink"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":              {chatIDS},
@@ -918,6 +3218,14 @@ func (handler *TelegramBotHandler) CreateChatInviteLink(chatID interface{},
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For creating invite link to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished creating invite link to telegram chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -933,6 +3241,13 @@ func (handler *TelegramBotHandler) CreateChatInviteLink(chatID interface{},
 /* CreateJoinRequest      bool */
 func (handler *TelegramBotHandler) EditChatInviteLink(chatID interface{}, inviteLink string,
 	optionals *entity.Optional) (*entity.ChatInviteLinkResponse, error) {
+	return handler.EditChatInviteLinkCtx(context.Background(), chatID, inviteLink, optionals)
+}
+
+// EditChatInviteLinkCtx is the context aware version of EditChatInviteLink
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) EditChatInviteLinkCtx(ctx context.Context, chatID interface{}, inviteLink string,
+	optionals *entity.Optional) (*entity.ChatInviteLinkResponse, error) {
 
 	chatIDS := ""
 
@@ -963,7 +3278,7 @@ func (handler *TelegramBotHandler) EditChatInviteLink(chatID interface{}, invite
 		chatIDS, inviteLink, name, expireDate, memberLimit, createsJoinRequest), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/editChatInviteLink"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":              {chatIDS},
@@ -996,6 +3311,14 @@ func (handler *TelegramBotHandler) EditChatInviteLink(chatID interface{}, invite
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For editing invite link to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished editing invite link to telegram chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1006,6 +3329,13 @@ func (handler *TelegramBotHandler) EditChatInviteLink(chatID interface{}, invite
 // RevokeChatInviteLink revokes an invite link created by the bot.
 func (handler *TelegramBotHandler) RevokeChatInviteLink(chatID interface{},
 	inviteLink string) (*entity.ChatInviteLinkResponse, error) {
+	return handler.RevokeChatInviteLinkCtx(context.Background(), chatID, inviteLink)
+}
+
+// RevokeChatInviteLinkCtx is the context aware version of RevokeChatInviteLink
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) RevokeChatInviteLinkCtx(ctx context.Context, chatID interface{},
+	inviteLink string) (*entity.ChatInviteLinkResponse, error) {
 
 	chatIDS := ""
 	if id, ok := chatID.(int64); ok {
@@ -1021,7 +3351,7 @@ func (handler *TelegramBotHandler) RevokeChatInviteLink(chatID interface{},
 		log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/revokeChatInviteLink"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":     {chatIDS},
@@ -1047,6 +3377,14 @@ func (handler *TelegramBotHandler) RevokeChatInviteLink(chatID interface{},
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For revoking invite link, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished revoking invite link, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1057,6 +3395,13 @@ func (handler *TelegramBotHandler) RevokeChatInviteLink(chatID interface{},
 // ApproveChatJoinRequest approves a chat join request.
 func (handler *TelegramBotHandler) ApproveChatJoinRequest(chatID interface{},
 	userID int64) (*entity.ChatDefaultResponse, error) {
+	return handler.ApproveChatJoinRequestCtx(context.Background(), chatID, userID)
+}
+
+// ApproveChatJoinRequestCtx is the context aware version of ApproveChatJoinRequest
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) ApproveChatJoinRequestCtx(ctx context.Context, chatID interface{},
+	userID int64) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 	if id, ok := chatID.(int64); ok {
@@ -1072,7 +3417,7 @@ func (handler *TelegramBotHandler) ApproveChatJoinRequest(chatID interface{},
 		log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/approveChatJoinRequest"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id": {chatIDS},
@@ -1098,6 +3443,14 @@ func (handler *TelegramBotHandler) ApproveChatJoinRequest(chatID interface{},
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For approving chat join request, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished approving chat join request, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1108,6 +3461,13 @@ func (handler *TelegramBotHandler) ApproveChatJoinRequest(chatID interface{},
 // DeclineChatJoinRequest declines a chat join request.
 func (handler *TelegramBotHandler) DeclineChatJoinRequest(chatID interface{},
 	userID int64) (*entity.ChatDefaultResponse, error) {
+	return handler.DeclineChatJoinRequestCtx(context.Background(), chatID, userID)
+}
+
+// DeclineChatJoinRequestCtx is the context aware version of DeclineChatJoinRequest
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) DeclineChatJoinRequestCtx(ctx context.Context, chatID interface{},
+	userID int64) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 	if id, ok := chatID.(int64); ok {
@@ -1123,7 +3483,7 @@ func (handler *TelegramBotHandler) DeclineChatJoinRequest(chatID interface{},
 		log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/declineChatJoinRequest"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id": {chatIDS},
@@ -1149,6 +3509,14 @@ func (handler *TelegramBotHandler) DeclineChatJoinRequest(chatID interface{},
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For declining chat join request, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished declining chat join request, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1162,6 +3530,13 @@ func (handler *TelegramBotHandler) DeclineChatJoinRequest(chatID interface{},
 /* RevokeMessages             bool */
 func (handler *TelegramBotHandler) BanChatMember(chatID interface{}, userID int64,
 	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.BanChatMemberCtx(context.Background(), chatID, userID, optionals)
+}
+
+// BanChatMemberCtx is the context aware version of BanChatMember
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) BanChatMemberCtx(ctx context.Context, chatID interface{}, userID int64,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 
@@ -1187,7 +3562,7 @@ func (handler *TelegramBotHandler) BanChatMember(chatID interface{}, userID int6
 		"Until Date : %d, Revoke Messages : %v }", chatIDS, userID, untilDate, revokeMessages), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/banChatMember"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":         {chatIDS},
@@ -1216,6 +3591,14 @@ func (handler *TelegramBotHandler) BanChatMember(chatID interface{}, userID int6
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For banning chat member, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished banning chat member, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1228,6 +3611,13 @@ func (handler *TelegramBotHandler) BanChatMember(chatID interface{}, userID int6
 /* OnlyIfBanned             bool */
 func (handler *TelegramBotHandler) UnbanChatMember(chatID interface{}, userID int64,
 	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.UnbanChatMemberCtx(context.Background(), chatID, userID, optionals)
+}
+
+// UnbanChatMemberCtx is the context aware version of UnbanChatMember
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) UnbanChatMemberCtx(ctx context.Context, chatID interface{}, userID int64,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 
@@ -1251,7 +3641,7 @@ func (handler *TelegramBotHandler) UnbanChatMember(chatID interface{}, userID in
 		chatIDS, userID, onlyIfBanned), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/unbanChatMember"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":        {chatIDS},
@@ -1279,6 +3669,14 @@ func (handler *TelegramBotHandler) UnbanChatMember(chatID interface{}, userID in
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For unbanning chat member, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished unbanning chat member, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1291,6 +3689,13 @@ func (handler *TelegramBotHandler) UnbanChatMember(chatID interface{}, userID in
 /* UntilDate                  int64 */
 func (handler *TelegramBotHandler) RestrictChatMember(chatID interface{}, userID int64, permissions *entity.ChatPermissions,
 	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.RestrictChatMemberCtx(context.Background(), chatID, userID, permissions, optionals)
+}
+
+// RestrictChatMemberCtx is the context aware version of RestrictChatMember
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) RestrictChatMemberCtx(ctx context.Context, chatID interface{}, userID int64,
+	permissions *entity.ChatPermissions, optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 
@@ -1318,7 +3723,7 @@ func (handler *TelegramBotHandler) RestrictChatMember(chatID interface{}, userID
 		"Until Date : %d, Permissions : %s }", chatIDS, userID, untilDate, permissions.ToString()), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/restrictChatMember"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":     {chatIDS},
@@ -1347,6 +3752,14 @@ func (handler *TelegramBotHandler) RestrictChatMember(chatID interface{}, userID
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For restricting chat member, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished restricting chat member, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1357,7 +3770,7 @@ func (handler *TelegramBotHandler) RestrictChatMember(chatID interface{}, userID
 // PromoteChatMember promote or demote a user in a supergroup or a channel.
 /* Available Optional Values */
 /* IsAnonymous                     bool */
-/* CanMangeChat                    bool */
+/* CanManageChat                   bool */
 /* CanPostMessages                 bool */
 /* CanEditMessages                 bool */
 /* CanDeleteMessages               bool */
@@ -1369,11 +3782,18 @@ func (handler *TelegramBotHandler) RestrictChatMember(chatID interface{}, userID
 /* CanPinMessages                  bool */
 func (handler *TelegramBotHandler) PromoteChatMember(chatID interface{}, userID int64,
 	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.PromoteChatMemberCtx(context.Background(), chatID, userID, optionals)
+}
+
+// PromoteChatMemberCtx is the context aware version of PromoteChatMember
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) PromoteChatMemberCtx(ctx context.Context, chatID interface{}, userID int64,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 
 	var isAnonymous bool
-	var canMangeChat bool
+	var canManageChat bool
 	var canPostMessages bool
 	var canEditMessages bool
 	var canDeleteMessages bool
@@ -1395,7 +3815,7 @@ func (handler *TelegramBotHandler) PromoteChatMember(chatID interface{}, userID
 	// If optionals aren't nil then set the values
 	if optionals != nil {
 		isAnonymous = optionals.IsAnonymous
-		canMangeChat = optionals.CanMangeChat
+		canManageChat = optionals.CanManageChat
 		canPostMessages = optionals.CanPostMessages
 		canEditMessages = optionals.CanEditMessages
 		canDeleteMessages = optionals.CanDeleteMessages
@@ -1409,20 +3829,20 @@ func (handler *TelegramBotHandler) PromoteChatMember(chatID interface{}, userID
 
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Started promoting chat member { Chat ID : %s, User ID : %d, "+
-		"IsAnonymous : %v, CanMangeChat : %v, CanPostMessages : %v, CanEditMessages : %v, CanDeleteMessages : %v, "+
+		"IsAnonymous : %v, CanManageChat : %v, CanPostMessages : %v, CanEditMessages : %v, CanDeleteMessages : %v, "+
 		"CanManageVoiceChats : %v, CanRestrictMembers : %v, CanPromoteMembers : %v, CanChangeInfo : %v, "+
-		"CanInviteUsers : %v, CanPinMessages : %v }", chatIDS, userID, isAnonymous, canMangeChat, canPostMessages,
+		"CanInviteUsers : %v, CanPinMessages : %v }", chatIDS, userID, isAnonymous, canManageChat, canPostMessages,
 		canEditMessages, canDeleteMessages, canManageVoiceChats, canRestrictMembers, canPromoteMembers, canChangeInfo,
 		canInviteUsers, canPinMessages), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/promoteChatMember"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":                {chatIDS},
 			"user_id":                {strconv.FormatInt(userID, 10)},
 			"is_anonymous":           {strconv.FormatBool(isAnonymous)},
-			"can_manage_chat":        {strconv.FormatBool(canMangeChat)},
+			"can_manage_chat":        {strconv.FormatBool(canManageChat)},
 			"can_post_messages":      {strconv.FormatBool(canPostMessages)},
 			"can_edit_messages":      {strconv.FormatBool(canEditMessages)},
 			"can_delete_messages":    {strconv.FormatBool(canDeleteMessages)},
@@ -1437,9 +3857,9 @@ func (handler *TelegramBotHandler) PromoteChatMember(chatID interface{}, userID
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
 		handler.Logging(fmt.Sprintf("Error: For promoting chat member { Chat ID : %s, User ID : %d, "+
-			"IsAnonymous : %v, CanMangeChat : %v, CanPostMessages : %v, CanEditMessages : %v, CanDeleteMessages : %v, "+
+			"IsAnonymous : %v, CanManageChat : %v, CanPostMessages : %v, CanEditMessages : %v, CanDeleteMessages : %v, "+
 			"CanManageVoiceChats : %v, CanRestrictMembers : %v, CanPromoteMembers : %v, CanChangeInfo : %v, "+
-			"CanInviteUsers : %v, CanPinMessages : %v }, %s", chatIDS, userID, isAnonymous, canMangeChat, canPostMessages,
+			"CanInviteUsers : %v, CanPinMessages : %v }, %s", chatIDS, userID, isAnonymous, canManageChat, canPostMessages,
 			canEditMessages, canDeleteMessages, canManageVoiceChats, canRestrictMembers, canPromoteMembers, canChangeInfo,
 			canInviteUsers, canPinMessages, err.Error()), log.ErrorLogFile)
 
@@ -1452,15 +3872,23 @@ func (handler *TelegramBotHandler) PromoteChatMember(chatID interface{}, userID
 	if err != nil {
 		/* ---------------------------- Logging ---------------------------- */
 		handler.Logging(fmt.Sprintf("Error: For promoting chat member, unable to parse response { Chat ID : %s, User ID : %d, "+
-			"IsAnonymous : %v, CanMangeChat : %v, CanPostMessages : %v, CanEditMessages : %v, CanDeleteMessages : %v, "+
+			"IsAnonymous : %v, CanManageChat : %v, CanPostMessages : %v, CanEditMessages : %v, CanDeleteMessages : %v, "+
 			"CanManageVoiceChats : %v, CanRestrictMembers : %v, CanPromoteMembers : %v, CanChangeInfo : %v, "+
-			"CanInviteUsers : %v, CanPinMessages : %v }, %s", chatIDS, userID, isAnonymous, canMangeChat, canPostMessages,
+			"CanInviteUsers : %v, CanPinMessages : %v }, %s", chatIDS, userID, isAnonymous, canManageChat, canPostMessages,
 			canEditMessages, canDeleteMessages, canManageVoiceChats, canRestrictMembers, canPromoteMembers, canChangeInfo,
 			canInviteUsers, canPinMessages, err.Error()), log.ErrorLogFile)
 
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For promoting chat member, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished promoting chat member, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1471,6 +3899,13 @@ func (handler *TelegramBotHandler) PromoteChatMember(chatID interface{}, userID
 // SetChatAdministratorCustomTitle sets a custom title for an administrator in a supergroup promoted by the bot.
 func (handler *TelegramBotHandler) SetChatAdministratorCustomTitle(chatID interface{}, userID int64,
 	customTitle string) (*entity.ChatDefaultResponse, error) {
+	return handler.SetChatAdministratorCustomTitleCtx(context.Background(), chatID, userID, customTitle)
+}
+
+// SetChatAdministratorCustomTitleCtx is the context aware version of SetChatAdministratorCustomTitle
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SetChatAdministratorCustomTitleCtx(ctx context.Context, chatID interface{},
+	userID int64, customTitle string) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 	if id, ok := chatID.(int64); ok {
@@ -1486,7 +3921,7 @@ func (handler *TelegramBotHandler) SetChatAdministratorCustomTitle(chatID interf
 		"Custom Title : %s }", chatIDS, userID, customTitle), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/setChatAdministratorCustomTitle"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":      {chatIDS},
@@ -1514,6 +3949,14 @@ func (handler *TelegramBotHandler) SetChatAdministratorCustomTitle(chatID interf
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For setting chat administrator's custom title, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished setting chat administrator's custom title, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1524,6 +3967,13 @@ func (handler *TelegramBotHandler) SetChatAdministratorCustomTitle(chatID interf
 // BanChatSenderChat bans a channel chat in a supergroup or a channel.
 func (handler *TelegramBotHandler) BanChatSenderChat(chatID interface{},
 	senderChatId int64) (*entity.ChatDefaultResponse, error) {
+	return handler.BanChatSenderChatCtx(context.Background(), chatID, senderChatId)
+}
+
+// BanChatSenderChatCtx is the context aware version of BanChatSenderChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) BanChatSenderChatCtx(ctx context.Context, chatID interface{},
+	senderChatId int64) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 
@@ -1540,7 +3990,7 @@ func (handler *TelegramBotHandler) BanChatSenderChat(chatID interface{},
 		chatIDS, senderChatId), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/banChatSenderChat"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":        {chatIDS},
@@ -1566,6 +4016,14 @@ func (handler *TelegramBotHandler) BanChatSenderChat(chatID interface{},
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For banning chat sender chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished banning chat sender chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1576,6 +4034,13 @@ func (handler *TelegramBotHandler) BanChatSenderChat(chatID interface{},
 // UnbanChatSenderChat unban a previously banned channel chat in a supergroup or channel.
 func (handler *TelegramBotHandler) UnbanChatSenderChat(chatID interface{},
 	senderChatId int64) (*entity.ChatDefaultResponse, error) {
+	return handler.UnbanChatSenderChatCtx(context.Background(), chatID, senderChatId)
+}
+
+// UnbanChatSenderChatCtx is the context aware version of UnbanChatSenderChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) UnbanChatSenderChatCtx(ctx context.Context, chatID interface{},
+	senderChatId int64) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 
@@ -1592,7 +4057,7 @@ func (handler *TelegramBotHandler) UnbanChatSenderChat(chatID interface{},
 		chatIDS, senderChatId), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/unbanChatSenderChat"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":        {chatIDS},
@@ -1618,6 +4083,14 @@ func (handler *TelegramBotHandler) UnbanChatSenderChat(chatID interface{},
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For unbanning chat sender chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished unbanning chat sender chat, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1628,6 +4101,13 @@ func (handler *TelegramBotHandler) UnbanChatSenderChat(chatID interface{},
 // SetChatPermissions sets default chat permissions for all members.
 func (handler *TelegramBotHandler) SetChatPermissions(chatID interface{},
 	permissions *entity.ChatPermissions) (*entity.ChatDefaultResponse, error) {
+	return handler.SetChatPermissionsCtx(context.Background(), chatID, permissions)
+}
+
+// SetChatPermissionsCtx is the context aware version of SetChatPermissions
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SetChatPermissionsCtx(ctx context.Context, chatID interface{},
+	permissions *entity.ChatPermissions) (*entity.ChatDefaultResponse, error) {
 
 	chatIDS := ""
 
@@ -1648,7 +4128,7 @@ func (handler *TelegramBotHandler) SetChatPermissions(chatID interface{},
 		chatIDS, permissions.ToString()), log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/setChatPermissions"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"chat_id":     {chatIDS},
@@ -1674,6 +4154,14 @@ func (handler *TelegramBotHandler) SetChatPermissions(chatID interface{},
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For setting chat permissions, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished setting chat permissions, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1689,6 +4177,13 @@ func (handler *TelegramBotHandler) SetChatPermissions(chatID interface{},
 /* CacheTime                int64 */
 func (handler *TelegramBotHandler) AnswerToTelegramCallBack(queryID string,
 	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.AnswerToTelegramCallBackCtx(context.Background(), queryID, optionals)
+}
+
+// AnswerToTelegramCallBackCtx is the context aware version of AnswerToTelegramCallBack
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) AnswerToTelegramCallBackCtx(ctx context.Context, queryID string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
 
 	text := ""
 	callbackUrl := ""
@@ -1709,7 +4204,7 @@ func (handler *TelegramBotHandler) AnswerToTelegramCallBack(queryID string,
 		log.BotLogFile)
 
 	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/answerCallbackQuery"
-	response, err := http.PostForm(
+	response, err := handler.postForm(ctx,
 		telegramAPI,
 		url.Values{
 			"callback_query_id": {queryID},
@@ -1740,6 +4235,14 @@ func (handler *TelegramBotHandler) AnswerToTelegramCallBack(queryID string,
 		return nil, err
 	}
 
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram callback, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
 	/* ---------------------------- Logging ---------------------------- */
 	handler.Logging(fmt.Sprintf("Finished answering to telegram callback, Bot Response => %s",
 		botResponse.ToString()), log.BotLogFile)
@@ -1747,6 +4250,110 @@ func (handler *TelegramBotHandler) AnswerToTelegramCallBack(queryID string,
 	return botResponse, nil
 }
 
+// AnswerToTelegramInlineQuery sends the results of the inline query identified by the query id
+/* At most 50 results are allowed per query, the type of each result is set from its go type when it is empty */
+/* Available Optional Values */
+/* CacheTime                int64 -- 300 if not provided */
+/* IsPersonal               bool */
+/* NextOffset               string */
+/* SwitchPMText             string */
+/* SwitchPMParameter        string */
+func (handler *TelegramBotHandler) AnswerToTelegramInlineQuery(queryID string, results []entity.InlineQueryResult,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.AnswerToTelegramInlineQueryCtx(context.Background(), queryID, results, optionals)
+}
+
+// AnswerToTelegramInlineQueryCtx is the context aware version of AnswerToTelegramInlineQuery
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) AnswerToTelegramInlineQueryCtx(ctx context.Context, queryID string,
+	results []entity.InlineQueryResult, optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+
+	nextOffset := ""
+	switchPMText := ""
+	switchPMParameter := ""
+
+	var isPersonal bool
+	var cacheTime int64 = 300
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		nextOffset = optionals.NextOffset
+		switchPMText = optionals.SwitchPMText
+		switchPMParameter = optionals.SwitchPMParameter
+		isPersonal = optionals.IsPersonal
+		if optionals.CacheTime > 0 {
+			cacheTime = optionals.CacheTime
+		}
+	}
+
+	if len(results) > 50 {
+		return nil, errors.New("inline query can only be answered with at most 50 results")
+	}
+
+	// An empty array is sent instead of null, so queries can be answered without results
+	if results == nil {
+		results = []entity.InlineQueryResult{}
+	}
+
+	resultsJSON, err := json.Marshal(results)
+	if err != nil {
+		return nil, err
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started answering to telegram inline query { Inline Query ID : %s, Results : %d, "+
+		"Cache Time : %d, Is Personal : %v, Next Offset : %s, Switch PM Text : %s, Switch PM Parameter : %s }",
+		queryID, len(results), cacheTime, isPersonal, nextOffset, switchPMText, switchPMParameter), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/answerInlineQuery"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"inline_query_id":     {queryID},
+			"results":             {string(resultsJSON)},
+			"cache_time":          {strconv.FormatInt(cacheTime, 10)},
+			"is_personal":         {strconv.FormatBool(isPersonal)},
+			"next_offset":         {nextOffset},
+			"switch_pm_text":      {switchPMText},
+			"switch_pm_parameter": {switchPMParameter},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram inline query { Inline Query ID : %s, "+
+			"Results : %d, Cache Time : %d, Is Personal : %v, Next Offset : %s }, %s", queryID, len(results),
+			cacheTime, isPersonal, nextOffset, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.ChatDefaultResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram inline query, unable to parse response "+
+			"{ Inline Query ID : %s, Results : %d, Cache Time : %d, Is Personal : %v, Next Offset : %s }, %s",
+			queryID, len(results), cacheTime, isPersonal, nextOffset, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram inline query, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished answering to telegram inline query, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
 // CreateReplyKeyboard is a function that creates a reply keyboard from set of parameters
 /* ResizeKeyboard              bool -- True if not provided */
 /* OneTimeKeyboard             bool */
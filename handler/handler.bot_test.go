@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// newFormAPI is a function that returns a fake api handler that records the received form values
+/* Every request is answered with the given result */
+func newFormAPI(requests *[]url.Values, result string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		*requests = append(*requests, r.PostForm)
+		w.Write([]byte(`{"ok":true,"result":` + result + `}`))
+	}
+}
+
+func TestAnswerInlineQueryResultLimit(t *testing.T) {
+
+	var requests []url.Values
+	bot := newTestBot(t, newFormAPI(&requests, "true"))
+
+	results := make([]entity.InlineQueryResult, 51)
+	for i := range results {
+		results[i] = entity.InlineQueryResultArticle{ID: strconv.Itoa(i)}
+	}
+
+	if _, err := bot.AnswerToTelegramInlineQuery("Q", results, nil); err == nil {
+		t.Error("expected an error for more than 50 results")
+	}
+
+	if len(requests) != 0 {
+		t.Fatalf("sent %d requests for more than 50 results, want none", len(requests))
+	}
+
+	if _, err := bot.AnswerToTelegramInlineQuery("Q", results[:50], nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Queries can also be answered without results
+	if _, err := bot.AnswerToTelegramInlineQuery("Q", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 2 || requests[1].Get("results") != "[]" {
+		t.Errorf("sent %d requests, want 2 with the last one sending an empty results array", len(requests))
+	}
+}
+
+func TestSendAudioParseMode(t *testing.T) {
+
+	var requests []url.Values
+	bot := newTestBot(t, newFormAPI(&requests, `{"message_id":9}`))
+
+	// Captions are given through the optionals, so the default parse mode has to be kept
+	optionals := &entity.Optional{Caption: "<b>caption</b>"}
+	if _, err := bot.SendAudioToTelegramChat(int64(5), "AUDIO_ID", optionals); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := bot.SendVoiceToTelegramChat(int64(5), "VOICE_ID", optionals); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, request := range requests {
+		if request.Get("parse_mode") != "html" || request.Get("caption") != "<b>caption</b>" {
+			t.Errorf("request %d sent parse mode %q with caption %q, want html", i+1, request.Get("parse_mode"),
+				request.Get("caption"))
+		}
+	}
+}
+
+func TestEditCaptionParseMode(t *testing.T) {
+
+	var requests []url.Values
+	bot := newTestBot(t, newFormAPI(&requests, `{"message_id":9}`))
+
+	// The message is always identified through the optionals, so the default parse mode has to be kept
+	_, err := bot.EditCaptionToTelegramChat("<b>caption</b>", &entity.Optional{ChatID: int64(5), MessageID: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = bot.EditCaptionToTelegramChat("*caption*", &entity.Optional{ChatID: int64(5), MessageID: 9,
+		ParseMode: "MarkdownV2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, parseMode := range []string{"html", "MarkdownV2"} {
+		if requests[i].Get("parse_mode") != parseMode {
+			t.Errorf("request %d sent parse mode %q, want %q", i+1, requests[i].Get("parse_mode"), parseMode)
+		}
+	}
+
+	if requests[0].Get("chat_id") != "5" || requests[0].Get("message_id") != "9" {
+		t.Errorf("sent chat id %q and message id %q, want 5 and 9", requests[0].Get("chat_id"),
+			requests[0].Get("message_id"))
+	}
+}
+
+func TestAnswerInlineQueryCacheTime(t *testing.T) {
+
+	var requests []url.Values
+	bot := newTestBot(t, newFormAPI(&requests, "true"))
+
+	// Optionals used only for paginating keep the default cache time
+	optionals := []*entity.Optional{nil, {NextOffset: "10", IsPersonal: true}, {CacheTime: 5}}
+	for _, optional := range optionals {
+		if _, err := bot.AnswerToTelegramInlineQuery("Q", nil, optional); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i, cacheTime := range []string{"300", "300", "5"} {
+		if requests[i].Get("cache_time") != cacheTime {
+			t.Errorf("request %d sent cache time %q, want %q", i+1, requests[i].Get("cache_time"), cacheTime)
+		}
+	}
+
+	if requests[1].Get("next_offset") != "10" || requests[1].Get("is_personal") != "true" {
+		t.Errorf("sent next offset %q and is personal %q, want 10 and true", requests[1].Get("next_offset"),
+			requests[1].Get("is_personal"))
+	}
+}
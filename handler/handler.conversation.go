@@ -0,0 +1,358 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// ConversationEnd is the state name a state handler returns for ending the conversation
+const ConversationEnd = ""
+
+// ConversationState is a type that holds the current state of a conversation along with its data
+type ConversationState struct {
+	Name      string            `json:"name"`
+	Data      map[string]string `json:"data"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// IConversationStore is an interface that defines the storage used for keeping the conversation states
+type IConversationStore interface {
+	GetState(key string) (*ConversationState, error) // Should return nil state and nil error if the state doesn't exist
+	SetState(key string, state *ConversationState) error
+	DeleteState(key string) error
+}
+
+// MemoryConversationStore is a type that keeps the conversation states in memory
+type MemoryConversationStore struct {
+	mu     sync.Mutex
+	states map[string]*ConversationState
+}
+
+// NewMemoryConversationStore is a function that returns a new in memory conversation store
+func NewMemoryConversationStore() *MemoryConversationStore {
+	return &MemoryConversationStore{states: make(map[string]*ConversationState)}
+}
+
+// GetState is a method that returns a copy of the conversation state stored using the key
+func (store *MemoryConversationStore) GetState(key string) (*ConversationState, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	state, ok := store.states[key]
+	if !ok {
+		return nil, nil
+	}
+
+	return copyConversationState(state), nil
+}
+
+// SetState is a method that stores a copy of the conversation state using the key
+func (store *MemoryConversationStore) SetState(key string, state *ConversationState) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.states[key] = copyConversationState(state)
+	return nil
+}
+
+// DeleteState is a method that removes the conversation state stored using the key
+func (store *MemoryConversationStore) DeleteState(key string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	delete(store.states, key)
+	return nil
+}
+
+// copyConversationState is a function that returns a deep copy of the conversation state
+func copyConversationState(state *ConversationState) *ConversationState {
+
+	copied := &ConversationState{Name: state.Name, UpdatedAt: state.UpdatedAt,
+		Data: make(map[string]string, len(state.Data))}
+
+	for key, value := range state.Data {
+		copied.Data[key] = value
+	}
+
+	return copied
+}
+
+// StateHandlerFunc is a type that defines a function that handles an update within a conversation state
+/* It returns the name of the next state, returning ConversationEnd ends the conversation and returning */
+/* the current state name keeps the conversation in the same state. Changes to the state data are stored */
+/* only if no error is returned */
+type StateHandlerFunc func(ctx *UpdateContext, state *ConversationState) (string, error)
+
+// transition is a type that pairs a state handler with the matchers that should all match for it to be selected
+type transition struct {
+	matchers []UpdateMatcher
+	handler  StateHandlerFunc
+}
+
+// Conversation is a type that defines a multi step flow as a set of named states keyed by the chat and the user
+/* Updates that don't match any transition of the current state aren't handled by the conversation, */
+/* so a transition without matchers can be added to handle invalid inputs. */
+/* Updates of the same chat and user should be handled sequentially, which is the case with the update poller */
+type Conversation struct {
+	Name string
+
+	store          IConversationStore
+	entries        []*transition
+	states         map[string][]*transition
+	timeout        time.Duration
+	timeoutHandler UpdateHandlerFunc
+	cancelCommands []string
+	cancelHandler  UpdateHandlerFunc
+}
+
+// conversationAction is a type that holds the decision made while matching an update against the conversation
+type conversationAction struct {
+	key        string
+	state      *ConversationState
+	transition *transition
+	cancelled  bool
+	expired    bool
+	err        error
+}
+
+// NewConversation is a function that returns a new conversation that keeps its states in the given store
+/* If the store is nil an in memory store is used */
+func NewConversation(name string, store IConversationStore) *Conversation {
+
+	if store == nil {
+		store = NewMemoryConversationStore()
+	}
+
+	return &Conversation{Name: name, store: store, states: make(map[string][]*transition)}
+}
+
+// AddEntry is a method that adds a transition that starts the conversation when all the matchers match
+func (conversation *Conversation) AddEntry(handler StateHandlerFunc, matchers ...UpdateMatcher) {
+	conversation.entries = append(conversation.entries, &transition{matchers: matchers, handler: handler})
+}
+
+// AddTransition is a method that adds a transition from the given state, selected when all the matchers match
+/* Transitions of a state are checked in the order they are added */
+func (conversation *Conversation) AddTransition(state string, handler StateHandlerFunc, matchers ...UpdateMatcher) {
+	conversation.states[state] = append(conversation.states[state], &transition{matchers: matchers, handler: handler})
+}
+
+// SetTimeout is a method that ends conversations that have been idle for longer than the timeout
+/* The timeout is detected when the next update of the conversation is received, then the state is removed */
+/* and the handler, which can be nil, is called before checking if the update starts a new conversation */
+func (conversation *Conversation) SetTimeout(timeout time.Duration, handler UpdateHandlerFunc) {
+	conversation.timeout = timeout
+	conversation.timeoutHandler = handler
+}
+
+// SetCancel is a method that sets the commands that end an active conversation from any state
+/* The handler, which can be nil, is called after the conversation is ended */
+func (conversation *Conversation) SetCancel(handler UpdateHandlerFunc, commands ...string) {
+	conversation.cancelHandler = handler
+	conversation.cancelCommands = nil
+	for _, command := range commands {
+		conversation.cancelCommands = append(conversation.cancelCommands, strings.TrimPrefix(command, "/"))
+	}
+}
+
+// Key is a method that returns the key used for storing the conversation state of the update's chat and user
+/* The second return value is false if the update doesn't belong to a chat */
+func (conversation *Conversation) Key(ctx *UpdateContext) (string, bool) {
+
+	chat := ctx.Chat()
+	if chat == nil {
+		return "", false
+	}
+
+	var userID int64
+	if user := ctx.Sender(); user != nil {
+		userID = user.ID
+	}
+
+	return fmt.Sprintf("%s:%d:%d", conversation.Name, chat.ID, userID), true
+}
+
+// State is a method that returns the current state of the conversation for the update's chat and user
+/* A nil state is returned if there is no active conversation */
+func (conversation *Conversation) State(ctx *UpdateContext) (*ConversationState, error) {
+
+	key, ok := conversation.Key(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	return conversation.store.GetState(key)
+}
+
+// End is a method that ends the conversation for the update's chat and user
+func (conversation *Conversation) End(ctx *UpdateContext) error {
+
+	key, ok := conversation.Key(ctx)
+	if !ok {
+		return nil
+	}
+
+	return conversation.store.DeleteState(key)
+}
+
+// HandleConversation is a method that registers the conversation as a route of the dispatcher
+func (dispatcher *Dispatcher) HandleConversation(conversation *Conversation) {
+	dispatcher.Handle(conversation.handle, conversation.match)
+}
+
+// actionKey is a method that returns the context key used for passing the matched action to the handler
+func (conversation *Conversation) actionKey() string {
+	return "conversation:" + conversation.Name
+}
+
+// match is a method that decides whether the update should be handled by the conversation
+func (conversation *Conversation) match(ctx *UpdateContext) bool {
+
+	key, ok := conversation.Key(ctx)
+	if !ok {
+		return false
+	}
+
+	action := &conversationAction{key: key}
+
+	state, err := conversation.store.GetState(key)
+	if err != nil {
+		// Passing the error to the handler so it reaches the error handler
+		action.err = err
+		ctx.Set(conversation.actionKey(), action)
+		return true
+	}
+
+	if state != nil && conversation.timeout > 0 && time.Since(state.UpdatedAt) > conversation.timeout {
+		action.expired = true
+		state = nil
+	}
+
+	if state != nil {
+		action.state = state
+
+		if conversation.isCancelCommand(ctx) {
+			action.cancelled = true
+			ctx.Set(conversation.actionKey(), action)
+			return true
+		}
+
+		action.transition = selectTransition(ctx, conversation.states[state.Name])
+	} else {
+		action.transition = selectTransition(ctx, conversation.entries)
+	}
+
+	if action.transition == nil {
+		if !action.expired {
+			return false
+		}
+
+		// An expired conversation is only handled if there is a timeout handler to notify the user
+		if conversation.timeoutHandler == nil {
+			conversation.store.DeleteState(key)
+			return false
+		}
+	}
+
+	ctx.Set(conversation.actionKey(), action)
+	return true
+}
+
+// handle is a method that performs the action selected while matching the update
+func (conversation *Conversation) handle(ctx *UpdateContext) error {
+
+	value, _ := ctx.Get(conversation.actionKey())
+	action, ok := value.(*conversationAction)
+	if !ok {
+		return nil
+	}
+
+	if action.err != nil {
+		return action.err
+	}
+
+	if action.cancelled {
+		if err := conversation.store.DeleteState(action.key); err != nil {
+			return err
+		}
+
+		if conversation.cancelHandler != nil {
+			return conversation.cancelHandler(ctx)
+		}
+		return nil
+	}
+
+	if action.expired {
+		if err := conversation.store.DeleteState(action.key); err != nil {
+			return err
+		}
+
+		if conversation.timeoutHandler != nil {
+			if err := conversation.timeoutHandler(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if action.transition == nil {
+		return nil
+	}
+
+	state := action.state
+	if state == nil {
+		state = &ConversationState{Data: make(map[string]string)}
+	} else if state.Data == nil {
+		state.Data = make(map[string]string)
+	}
+
+	next, err := action.transition.handler(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	if next == ConversationEnd {
+		return conversation.store.DeleteState(action.key)
+	}
+
+	state.Name = next
+	state.UpdatedAt = time.Now()
+
+	return conversation.store.SetState(action.key, state)
+}
+
+// isCancelCommand is a method that checks if the update is one of the cancel commands of the conversation
+func (conversation *Conversation) isCancelCommand(ctx *UpdateContext) bool {
+
+	if ctx.Update.Type() != entity.UpdateTypeMessage || len(conversation.cancelCommands) == 0 {
+		return false
+	}
+
+	name, _, ok := parseCommand(ctx.Message().Text, ctx.botUsername())
+	if !ok {
+		return false
+	}
+
+	for _, command := range conversation.cancelCommands {
+		if strings.EqualFold(name, command) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectTransition is a function that returns the first transition whose matchers all match the update
+func selectTransition(ctx *UpdateContext, transitions []*transition) *transition {
+
+	for _, transition := range transitions {
+		if matchAll(ctx, transition.matchers) {
+			return transition
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// regexpWord and regexpNumber are the patterns of the valid inputs of the signup conversation
+var (
+	regexpWord   = regexp.MustCompile(`^[a-z]+$`)
+	regexpNumber = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// newUserTextUpdate is a function that returns an update containing a text message sent by the user in a private chat
+func newUserTextUpdate(userID int64, text string) *entity.Update {
+	return &entity.Update{UpdateID: 1, Message: entity.Message{MessageID: 1, Text: text,
+		From: entity.User{ID: userID}, Chat: entity.Chat{ID: userID, Type: entity.ChatTypePrivate}}}
+}
+
+// newSignupConversation is a function that returns a conversation asking for a name and an age
+/* The replies of the conversation are recorded in the given slice */
+func newSignupConversation(replies *[]string) *Conversation {
+
+	conversation := NewConversation("signup", nil)
+
+	conversation.AddEntry(func(ctx *UpdateContext, state *ConversationState) (string, error) {
+		*replies = append(*replies, "name?")
+		return "name", nil
+	}, MatchCommand("signup"))
+
+	conversation.AddTransition("name", func(ctx *UpdateContext, state *ConversationState) (string, error) {
+		state.Data["name"] = ctx.Text()
+		*replies = append(*replies, "age?")
+		return "age", nil
+	}, MatchRegex(regexpWord))
+
+	conversation.AddTransition("age", func(ctx *UpdateContext, state *ConversationState) (string, error) {
+		*replies = append(*replies, "done "+state.Data["name"]+" "+ctx.Text())
+		return ConversationEnd, nil
+	}, MatchRegex(regexpNumber))
+
+	// Transitions without matchers handle the invalid inputs of a state
+	conversation.AddTransition("age", func(ctx *UpdateContext, state *ConversationState) (string, error) {
+		*replies = append(*replies, "invalid age")
+		return "age", nil
+	})
+
+	return conversation
+}
+
+func TestConversationTransitions(t *testing.T) {
+
+	var replies []string
+	conversation := newSignupConversation(&replies)
+
+	dispatcher := NewDispatcher(NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil))
+	dispatcher.HandleConversation(conversation)
+	dispatcher.SetFallback(func(ctx *UpdateContext) error {
+		replies = append(replies, "fallback")
+		return nil
+	})
+
+	for _, text := range []string{"bob", "/signup", "bob", "old", "30", "40"} {
+		dispatcher.Dispatch(newUserTextUpdate(7, text))
+	}
+
+	// Updates outside the conversation and updates completing it reach the other routes
+	want := "fallback, name?, age?, invalid age, done bob 30, fallback"
+	if got := strings.Join(replies, ", "); got != want {
+		t.Errorf("replies are %q, want %q", got, want)
+	}
+}
+
+func TestConversationKeyedByUser(t *testing.T) {
+
+	var replies []string
+	conversation := newSignupConversation(&replies)
+
+	dispatcher := NewDispatcher(NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil))
+	dispatcher.HandleConversation(conversation)
+
+	dispatcher.Dispatch(newUserTextUpdate(7, "/signup"))
+	dispatcher.Dispatch(newUserTextUpdate(8, "/signup"))
+	dispatcher.Dispatch(newUserTextUpdate(7, "bob"))
+
+	ctx := &UpdateContext{Update: newUserTextUpdate(7, "")}
+	if state, err := conversation.State(ctx); err != nil || state == nil || state.Name != "age" ||
+		state.Data["name"] != "bob" {
+		t.Errorf("state of the first user is %+v with error %v, want age with the name", state, err)
+	}
+
+	ctx = &UpdateContext{Update: newUserTextUpdate(8, "")}
+	if state, err := conversation.State(ctx); err != nil || state == nil || state.Name != "name" {
+		t.Errorf("state of the second user is %+v with error %v, want name", state, err)
+	}
+}
+
+func TestConversationCancel(t *testing.T) {
+
+	var replies []string
+	conversation := newSignupConversation(&replies)
+	conversation.SetCancel(func(ctx *UpdateContext) error {
+		replies = append(replies, "cancelled")
+		return nil
+	}, "/cancel", "stop")
+
+	dispatcher := NewDispatcher(NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil))
+	dispatcher.HandleConversation(conversation)
+
+	// Cancel commands are only handled within an active conversation, and commands for other bots are ignored
+	for _, text := range []string{"/cancel", "/signup", "/cancel@OtherBot", "/STOP@TestBot", "bob"} {
+		dispatcher.Dispatch(newUserTextUpdate(7, text))
+	}
+
+	want := "name?, cancelled"
+	if got := strings.Join(replies, ", "); got != want {
+		t.Errorf("replies are %q, want %q", got, want)
+	}
+
+	if state, _ := conversation.State(&UpdateContext{Update: newUserTextUpdate(7, "")}); state != nil {
+		t.Errorf("state is %+v after cancelling, want no state", state)
+	}
+}
+
+func TestConversationCancelWithoutBot(t *testing.T) {
+
+	var replies []string
+	conversation := newSignupConversation(&replies)
+	conversation.SetCancel(func(ctx *UpdateContext) error {
+		replies = append(replies, "cancelled")
+		return nil
+	}, "cancel")
+
+	// Without a bot handler cancel commands addressed to any bot are accepted
+	dispatcher := NewDispatcher(nil)
+	dispatcher.HandleConversation(conversation)
+
+	for _, text := range []string{"/signup", "/cancel@OtherBot", "bob"} {
+		dispatcher.Dispatch(newUserTextUpdate(7, text))
+	}
+
+	want := "name?, cancelled"
+	if got := strings.Join(replies, ", "); got != want {
+		t.Errorf("replies are %q, want %q", got, want)
+	}
+}
+
+func TestConversationTimeout(t *testing.T) {
+
+	var replies []string
+	conversation := newSignupConversation(&replies)
+	conversation.SetTimeout(20*time.Millisecond, func(ctx *UpdateContext) error {
+		replies = append(replies, "timed out")
+		return nil
+	})
+
+	dispatcher := NewDispatcher(NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil))
+	dispatcher.HandleConversation(conversation)
+
+	dispatcher.Dispatch(newUserTextUpdate(7, "/signup"))
+	time.Sleep(30 * time.Millisecond)
+
+	// The expired conversation is ended before the update is checked, so the name isn't accepted anymore
+	dispatcher.Dispatch(newUserTextUpdate(7, "bob"))
+
+	// An update starting the conversation again starts it after notifying the timeout
+	dispatcher.Dispatch(newUserTextUpdate(7, "/signup"))
+	time.Sleep(30 * time.Millisecond)
+	dispatcher.Dispatch(newUserTextUpdate(7, "/signup"))
+
+	want := "name?, timed out, name?, timed out, name?"
+	if got := strings.Join(replies, ", "); got != want {
+		t.Errorf("replies are %q, want %q", got, want)
+	}
+}
+
+func TestConversationHandlerError(t *testing.T) {
+
+	conversation := NewConversation("failing", nil)
+	conversation.AddEntry(func(ctx *UpdateContext, state *ConversationState) (string, error) {
+		state.Data["started"] = "true"
+		return "started", errors.New("failed")
+	}, MatchCommand("start"))
+
+	dispatcher := NewDispatcher(NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil))
+	dispatcher.HandleConversation(conversation)
+	dispatcher.SetErrorHandler(func(ctx *UpdateContext, err error) {})
+
+	update := newUserTextUpdate(7, "/start")
+	if err := dispatcher.DispatchContext(context.Background(), update); err == nil {
+		t.Error("expected the error of the state handler to be returned")
+	}
+
+	// The state isn't stored when the handler fails
+	if state, _ := conversation.State(&UpdateContext{Update: update}); state != nil {
+		t.Errorf("state is %+v after a failed handler, want no state", state)
+	}
+}
@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+	"github.com/Benyam-S/go-tg-bot/log"
+)
+
+// UpdateHandlerFunc is a type that defines a function that handles an update routed by the dispatcher
+type UpdateHandlerFunc func(ctx *UpdateContext) error
+
+// UpdateMatcher is a type that defines a function that decides whether an update should be passed to a handler
+/* A matcher may set values on the context, like the command arguments or the regex matches */
+type UpdateMatcher func(ctx *UpdateContext) bool
+
+// ErrorHandlerFunc is a type that defines a function that handles an error returned by an update handler
+type ErrorHandlerFunc func(ctx *UpdateContext, err error)
+
+// UpdateContext is a type that holds the update being handled along with values set while routing it
+type UpdateContext struct {
+	Context context.Context
+	Bot     *TelegramBotHandler
+	Update  *entity.Update
+	Command string   // The command without the leading '/' and the bot username, set by the command matcher
+	Args    string   // The text following the command or the callback data following the prefix
+	Matches []string // The regex sub matches, set by the regex matcher
+	values  map[string]interface{}
+}
+
+// Set is a method that stores a value on the context, used for passing values between middlewares and handlers
+func (ctx *UpdateContext) Set(key string, value interface{}) {
+	if ctx.values == nil {
+		ctx.values = make(map[string]interface{})
+	}
+
+	ctx.values[key] = value
+}
+
+// Get is a method that returns a value stored on the context
+func (ctx *UpdateContext) Get(key string) (interface{}, bool) {
+	value, ok := ctx.values[key]
+	return value, ok
+}
+
+// Message is a method that returns the message the update is related with, nil if there is no message
+func (ctx *UpdateContext) Message() *entity.Message {
+	return ctx.Update.EffectiveMessage()
+}
+
+// Chat is a method that returns the chat the update is related with, nil if there is no chat
+func (ctx *UpdateContext) Chat() *entity.Chat {
+	return ctx.Update.EffectiveChat()
+}
+
+// Sender is a method that returns the user that caused the update, nil if there is no user
+func (ctx *UpdateContext) Sender() *entity.User {
+	return ctx.Update.EffectiveUser()
+}
+
+// Text is a method that returns the text of the update, which is the callback data for callback queries
+/* and the text or the caption for messages */
+func (ctx *UpdateContext) Text() string {
+
+	if ctx.Update.Type() == entity.UpdateTypeCallbackQuery {
+		return ctx.Update.CallbackQuery.Data
+	}
+
+	if message := ctx.Message(); message != nil {
+		if message.Text != "" {
+			return message.Text
+		}
+		return message.Caption
+	}
+
+	return ""
+}
+
+// botUsername is a method that returns the username of the bot, empty if the dispatcher has no bot handler
+func (ctx *UpdateContext) botUsername() string {
+	if ctx.Bot == nil {
+		return ""
+	}
+	return ctx.Bot.BotUsername
+}
+
+// route is a type that pairs an update handler with the matchers that should all match for it to be selected
+type route struct {
+	matchers []UpdateMatcher
+	handler  UpdateHandlerFunc
+}
+
+// Dispatcher is a type that routes updates to the registered handlers
+/* Routes are checked in the order they are registered and the first route whose matchers all match is used */
+/* Handlers should be registered before the dispatcher starts receiving updates */
+type Dispatcher struct {
+	bot          *TelegramBotHandler
+	routes       []*route
+	middlewares  []Middleware
+	fallback     UpdateHandlerFunc
+	errorHandler ErrorHandlerFunc
+}
+
+// NewDispatcher is a function that returns a new dispatcher for the given bot handler
+func NewDispatcher(bot *TelegramBotHandler) *Dispatcher {
+	return &Dispatcher{bot: bot}
+}
+
+// Handle is a method that registers a handler that is selected when all the matchers match
+/* A handler without matchers matches every update */
+func (dispatcher *Dispatcher) Handle(handler UpdateHandlerFunc, matchers ...UpdateMatcher) {
+	dispatcher.routes = append(dispatcher.routes, &route{matchers: matchers, handler: handler})
+}
+
+// HandleCommand is a method that registers a handler for the given '/command'
+func (dispatcher *Dispatcher) HandleCommand(command string, handler UpdateHandlerFunc) {
+	dispatcher.Handle(handler, MatchCommand(command))
+}
+
+// HandleCallback is a method that registers a handler for callback queries whose data starts with the prefix
+func (dispatcher *Dispatcher) HandleCallback(prefix string, handler UpdateHandlerFunc) {
+	dispatcher.Handle(handler, MatchCallbackPrefix(prefix))
+}
+
+// HandleRegex is a method that registers a handler for updates whose text matches the pattern
+func (dispatcher *Dispatcher) HandleRegex(pattern *regexp.Regexp, handler UpdateHandlerFunc) {
+	dispatcher.Handle(handler, MatchRegex(pattern))
+}
+
+// HandleChatType is a method that registers a handler for updates coming from the given chat types
+func (dispatcher *Dispatcher) HandleChatType(handler UpdateHandlerFunc, chatTypes ...string) {
+	dispatcher.Handle(handler, MatchChatType(chatTypes...))
+}
+
+// HandleUpdateType is a method that registers a handler for the given update types
+func (dispatcher *Dispatcher) HandleUpdateType(handler UpdateHandlerFunc, updateTypes ...string) {
+	dispatcher.Handle(handler, MatchUpdateType(updateTypes...))
+}
+
+// SetFallback is a method that sets the handler used when no registered handler matches the update
+func (dispatcher *Dispatcher) SetFallback(handler UpdateHandlerFunc) {
+	dispatcher.fallback = handler
+}
+
+// SetErrorHandler is a method that sets the handler for the errors returned by the update handlers
+/* If no error handler is set the errors are logged to the error log file of the bot handler, if there is one */
+func (dispatcher *Dispatcher) SetErrorHandler(handler ErrorHandlerFunc) {
+	dispatcher.errorHandler = handler
+}
+
+// Dispatch is a method that routes the update to the matching handler
+/* It can be directly used as the callback of the update poller or the webhook handler */
+func (dispatcher *Dispatcher) Dispatch(update *entity.Update) {
+	dispatcher.DispatchContext(context.Background(), update)
+}
+
+// DispatchContext is a method that routes the update to the matching handler using the given context
+/* The error returned by the handler is passed to the error handler and also returned */
+func (dispatcher *Dispatcher) DispatchContext(ctx context.Context, update *entity.Update) error {
+
+	updateCtx := &UpdateContext{Context: ctx, Bot: dispatcher.bot, Update: update}
+
+	// Wrapping the routing with the middlewares, the first registered middleware being the outermost
+	var handler UpdateHandlerFunc = dispatcher.route
+	for i := len(dispatcher.middlewares) - 1; i >= 0; i-- {
+		handler = dispatcher.middlewares[i](handler)
+	}
+
+	err := handler(updateCtx)
+	if err != nil {
+		if dispatcher.errorHandler != nil {
+			dispatcher.errorHandler(updateCtx, err)
+		} else if dispatcher.bot != nil {
+			/* ---------------------------- Logging ---------------------------- */
+			dispatcher.bot.Logging(fmt.Sprintf("Error: For handling update { Update ID : %d, Update Type : %s }, %s",
+				update.UpdateID, update.Type(), err.Error()), log.ErrorLogFile)
+		}
+	}
+
+	return err
+}
+
+// route is a method that selects the first matching handler, or the fallback, and runs it
+func (dispatcher *Dispatcher) route(ctx *UpdateContext) error {
+
+	for _, route := range dispatcher.routes {
+		if matchAll(ctx, route.matchers) {
+			return route.handler(ctx)
+		}
+	}
+
+	ctx.Command, ctx.Args, ctx.Matches = "", "", nil
+
+	if dispatcher.fallback != nil {
+		return dispatcher.fallback(ctx)
+	}
+
+	return nil
+}
+
+// matchAll is a function that checks if all the matchers match the update
+/* Values set on the context by the matchers of a previous check are cleared first */
+func matchAll(ctx *UpdateContext, matchers []UpdateMatcher) bool {
+
+	ctx.Command, ctx.Args, ctx.Matches = "", "", nil
+
+	for _, matcher := range matchers {
+		if !matcher(ctx) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchCommand is a function that returns a matcher for messages starting with the given '/command'
+/* Commands addressed to other bots using the '/command@BotUsername' format don't match */
+func MatchCommand(command string) UpdateMatcher {
+
+	command = strings.TrimPrefix(command, "/")
+
+	return func(ctx *UpdateContext) bool {
+
+		updateType := ctx.Update.Type()
+		if updateType != entity.UpdateTypeMessage && updateType != entity.UpdateTypeChannelPost {
+			return false
+		}
+
+		name, args, ok := parseCommand(ctx.Message().Text, ctx.botUsername())
+		if !ok || !strings.EqualFold(name, command) {
+			return false
+		}
+
+		ctx.Command = name
+		ctx.Args = args
+		return true
+	}
+}
+
+// MatchCallbackPrefix is a function that returns a matcher for callback queries whose data starts with the prefix
+/* The data following the prefix is set as the context arguments */
+func MatchCallbackPrefix(prefix string) UpdateMatcher {
+	return func(ctx *UpdateContext) bool {
+
+		if ctx.Update.Type() != entity.UpdateTypeCallbackQuery ||
+			!strings.HasPrefix(ctx.Update.CallbackQuery.Data, prefix) {
+			return false
+		}
+
+		ctx.Args = strings.TrimPrefix(ctx.Update.CallbackQuery.Data, prefix)
+		return true
+	}
+}
+
+// MatchRegex is a function that returns a matcher for updates whose text matches the pattern
+/* The sub matches are set as the context matches */
+func MatchRegex(pattern *regexp.Regexp) UpdateMatcher {
+	return func(ctx *UpdateContext) bool {
+
+		matches := pattern.FindStringSubmatch(ctx.Text())
+		if matches == nil {
+			return false
+		}
+
+		ctx.Matches = matches
+		return true
+	}
+}
+
+// MatchChatType is a function that returns a matcher for updates coming from any of the given chat types
+func MatchChatType(chatTypes ...string) UpdateMatcher {
+	return func(ctx *UpdateContext) bool {
+
+		chat := ctx.Chat()
+		if chat == nil {
+			return false
+		}
+
+		for _, chatType := range chatTypes {
+			if chat.Type == chatType {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// MatchUpdateType is a function that returns a matcher for updates of any of the given update types
+func MatchUpdateType(updateTypes ...string) UpdateMatcher {
+	return func(ctx *UpdateContext) bool {
+
+		current := ctx.Update.Type()
+		for _, updateType := range updateTypes {
+			if current == updateType {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// parseCommand is a function that extracts the command name and its arguments from a message text
+/* If the command is addressed to a bot other than the given bot username it isn't considered as a command, */
+/* when the bot username is empty commands addressed to any bot are accepted */
+func parseCommand(text, botUsername string) (string, string, bool) {
+
+	if !strings.HasPrefix(text, "/") {
+		return "", "", false
+	}
+
+	name := text[1:]
+	args := ""
+	if index := strings.IndexAny(name, " \t\n"); index >= 0 {
+		name, args = name[:index], strings.TrimSpace(name[index+1:])
+	}
+
+	if index := strings.Index(name, "@"); index >= 0 {
+		target := name[index+1:]
+		name = name[:index]
+
+		botUsername = strings.TrimPrefix(botUsername, "@")
+		if botUsername != "" && !strings.EqualFold(target, botUsername) {
+			return "", "", false
+		}
+	}
+
+	if name == "" {
+		return "", "", false
+	}
+
+	return name, args, true
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// newTextUpdate is a function that returns an update containing a text message sent in a chat of the given type
+func newTextUpdate(text, chatType string) *entity.Update {
+	return &entity.Update{UpdateID: 1, Message: entity.Message{MessageID: 1, Text: text,
+		Chat: entity.Chat{ID: 3, Type: chatType}}}
+}
+
+func TestDispatcherMatchers(t *testing.T) {
+
+	bot := NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil)
+	dispatcher := NewDispatcher(bot)
+
+	var routed string
+	record := func(name string) UpdateHandlerFunc {
+		return func(ctx *UpdateContext) error {
+			routed = name + "|" + ctx.Command + "|" + ctx.Args
+			if ctx.Matches != nil {
+				routed += "|" + ctx.Matches[1]
+			}
+			return nil
+		}
+	}
+
+	dispatcher.HandleCommand("start", record("command"))
+	dispatcher.HandleCallback("pick:", record("callback"))
+	dispatcher.HandleRegex(regexp.MustCompile(`^hi (\w+)`), record("regex"))
+	dispatcher.HandleChatType(record("group"), entity.ChatTypeGroup, entity.ChatTypeSuperGroup)
+	dispatcher.HandleUpdateType(record("edited"), entity.UpdateTypeEditedMessage)
+	dispatcher.SetFallback(record("fallback"))
+
+	tests := []struct {
+		name   string
+		update *entity.Update
+		want   string
+	}{
+		{"command", newTextUpdate("/start a b", entity.ChatTypePrivate), "command|start|a b"},
+		{"command for the bot", newTextUpdate("/START@testbot", entity.ChatTypePrivate), "command|START|"},
+		{"command for another bot", newTextUpdate("/start@OtherBot", entity.ChatTypePrivate), "fallback||"},
+		{"callback", &entity.Update{CallbackQuery: entity.CallbackQuery{ID: "1", Data: "pick:7"}}, "callback||7"},
+		{"callback with another prefix", &entity.Update{CallbackQuery: entity.CallbackQuery{ID: "1",
+			Data: "drop:7"}}, "fallback||"},
+		{"regex", newTextUpdate("hi bob", entity.ChatTypePrivate), "regex|||bob"},
+		{"chat type", newTextUpdate("hello", entity.ChatTypeSuperGroup), "group||"},
+		{"first matching route", newTextUpdate("/start", entity.ChatTypeGroup), "command|start|"},
+		{"update type", &entity.Update{EditedMessage: entity.Message{MessageID: 1, Text: "hello"}}, "edited||"},
+		{"fallback", newTextUpdate("hello", entity.ChatTypePrivate), "fallback||"},
+	}
+
+	for _, test := range tests {
+		routed = ""
+		if err := dispatcher.DispatchContext(context.Background(), test.update); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+
+		if routed != test.want {
+			t.Errorf("%s: routed to %q, want %q", test.name, routed, test.want)
+		}
+	}
+}
+
+func TestDispatcherErrorHandler(t *testing.T) {
+
+	bot := NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil)
+	dispatcher := NewDispatcher(bot)
+
+	handlerErr := errors.New("failed")
+	dispatcher.HandleCommand("fail", func(ctx *UpdateContext) error { return handlerErr })
+
+	var handled error
+	dispatcher.SetErrorHandler(func(ctx *UpdateContext, err error) { handled = err })
+
+	ctx := context.Background()
+	if err := dispatcher.DispatchContext(ctx, newTextUpdate("/fail", entity.ChatTypePrivate)); err != handlerErr {
+		t.Errorf("returned error is %v, want %v", err, handlerErr)
+	}
+
+	if handled != handlerErr {
+		t.Errorf("error handler received %v, want %v", handled, handlerErr)
+	}
+
+	// Updates without a matching route or fallback are ignored
+	handled = nil
+	if err := dispatcher.DispatchContext(ctx, newTextUpdate("hello", entity.ChatTypePrivate)); err != nil || handled != nil {
+		t.Errorf("unmatched update returned %v, want no error", err)
+	}
+}
+
+func TestDispatcherWithoutBot(t *testing.T) {
+
+	// Dispatchers created without a bot handler accept commands addressed to any bot
+	dispatcher := NewDispatcher(nil)
+
+	var commands []string
+	dispatcher.HandleCommand("start", func(ctx *UpdateContext) error {
+		commands = append(commands, ctx.Command)
+		return nil
+	})
+	dispatcher.HandleCommand("fail", func(ctx *UpdateContext) error { return errors.New("failed") })
+
+	for _, text := range []string{"/start", "/start@TestBot", "/START@OtherBot args"} {
+		if err := dispatcher.DispatchContext(context.Background(), newTextUpdate(text,
+			entity.ChatTypePrivate)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(commands) != 3 {
+		t.Errorf("handled %d commands, want 3", len(commands))
+	}
+
+	// Without an error handler or a bot handler the error is only returned
+	if err := dispatcher.DispatchContext(context.Background(), newTextUpdate("/fail",
+		entity.ChatTypePrivate)); err == nil {
+		t.Error("expected the handler error to be returned")
+	}
+}
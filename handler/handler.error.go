@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// ErrBotBlocked is matched by an API error returned when the bot was blocked by the user
+var ErrBotBlocked = errors.New("bot was blocked by the user")
+
+// ErrChatNotFound is matched by an API error returned when the chat doesn't exist or the bot can't access it
+var ErrChatNotFound = errors.New("chat not found")
+
+// ErrMessageNotModified is matched by an API error returned when an edit doesn't change the message
+var ErrMessageNotModified = errors.New("message is not modified")
+
+// ErrTooManyRequests is matched by an API error returned when the bot is flood limited
+var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrChatMigrated is matched by an API error returned when the group has been migrated to a supergroup
+var ErrChatMigrated = errors.New("chat migrated")
+
+// APIError is a type that represents an unsuccessful response returned by the telegram api
+/* Use errors.Is with the sentinel errors, like ErrBotBlocked, for checking common cases */
+type APIError struct {
+	ErrorCode   int64
+	Description string
+	Parameters  entity.ResponseParameters
+}
+
+// newAPIError is a function that returns an API error from the values of an unsuccessful response
+func newAPIError(errorCode int64, description string, parameters entity.ResponseParameters) *APIError {
+	return &APIError{ErrorCode: errorCode, Description: description, Parameters: parameters}
+}
+
+// Error is a method that returns the description of the API error
+func (apiError *APIError) Error() string {
+	return fmt.Sprintf("telegram api error %d: %s", apiError.ErrorCode, apiError.Description)
+}
+
+// Is is a method that reports whether the API error matches the target sentinel error
+func (apiError *APIError) Is(target error) bool {
+
+	description := strings.ToLower(apiError.Description)
+
+	switch target {
+	case ErrBotBlocked:
+		return apiError.ErrorCode == 403 && strings.Contains(description, "bot was blocked by the user")
+	case ErrChatNotFound:
+		return apiError.ErrorCode == 400 && strings.Contains(description, "chat not found")
+	case ErrMessageNotModified:
+		return apiError.ErrorCode == 400 && strings.Contains(description, "message is not modified")
+	case ErrTooManyRequests:
+		return apiError.ErrorCode == 429
+	case ErrChatMigrated:
+		return apiError.Parameters.MigrateToChatID != 0
+	}
+
+	return false
+}
+
+// RetryAfter is a method that returns the time to wait before repeating the request, zero if not provided
+func (apiError *APIError) RetryAfter() time.Duration {
+	return time.Duration(apiError.Parameters.RetryAfter) * time.Second
+}
+
+// MigrateToChatID is a method that returns the identifier of the supergroup the group was migrated to
+/* Zero is returned if the error isn't caused by a migration */
+func (apiError *APIError) MigrateToChatID() int64 {
+	return apiError.Parameters.MigrateToChatID
+}
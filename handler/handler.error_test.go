@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAPIError(t *testing.T) {
+
+	sentinels := []error{ErrBotBlocked, ErrChatNotFound, ErrMessageNotModified, ErrTooManyRequests, ErrChatMigrated}
+
+	tests := []struct {
+		name       string
+		code       int
+		body       string
+		matches    error // The only sentinel error the api error should match, nil if none
+		retryAfter time.Duration
+		migrateTo  int64
+	}{
+		{"blocked", http.StatusForbidden, `{"ok":false,"error_code":403,` +
+			`"description":"Forbidden: bot was blocked by the user"}`, ErrBotBlocked, 0, 0},
+		{"kicked", http.StatusForbidden, `{"ok":false,"error_code":403,` +
+			`"description":"Forbidden: bot was kicked from the group chat"}`, nil, 0, 0},
+		{"chat not found", http.StatusBadRequest, `{"ok":false,"error_code":400,` +
+			`"description":"Bad Request: chat not found"}`, ErrChatNotFound, 0, 0},
+		{"message not modified", http.StatusBadRequest, `{"ok":false,"error_code":400,"description":` +
+			`"Bad Request: message is not modified: specified new message content and reply markup are exactly ` +
+			`the same as a current content and reply markup of the message"}`, ErrMessageNotModified, 0, 0},
+		{"too many requests", http.StatusTooManyRequests, `{"ok":false,"error_code":429,` +
+			`"description":"Too Many Requests: retry after 35","parameters":{"retry_after":35}}`,
+			ErrTooManyRequests, 35 * time.Second, 0},
+		{"migrated", http.StatusBadRequest, `{"ok":false,"error_code":400,"description":` +
+			`"Bad Request: group chat was upgraded to a supergroup chat",` +
+			`"parameters":{"migrate_to_chat_id":-1001234567890}}`, ErrChatMigrated, 0, -1001234567890},
+		{"other", http.StatusBadRequest, `{"ok":false,"error_code":400,` +
+			`"description":"Bad Request: message text is empty"}`, nil, 0, 0},
+	}
+
+	for _, test := range tests {
+
+		code, body := test.code, test.body
+		bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(code)
+			w.Write([]byte(body))
+		})
+
+		_, err := bot.SendReplyToTelegramChat(int64(5), "hello", nil)
+
+		apiError := new(APIError)
+		if !errors.As(err, &apiError) {
+			t.Errorf("%s: returned error %v isn't an api error", test.name, err)
+			continue
+		}
+
+		if apiError.ErrorCode != int64(test.code) {
+			t.Errorf("%s: error code is %d, want %d", test.name, apiError.ErrorCode, test.code)
+		}
+
+		for _, sentinel := range sentinels {
+			if matches := errors.Is(err, sentinel); matches != (sentinel == test.matches) {
+				t.Errorf("%s: errors.Is(%v) is %t", test.name, sentinel, matches)
+			}
+		}
+
+		if apiError.RetryAfter() != test.retryAfter {
+			t.Errorf("%s: retry after is %s, want %s", test.name, apiError.RetryAfter(), test.retryAfter)
+		}
+
+		if apiError.MigrateToChatID() != test.migrateTo {
+			t.Errorf("%s: migrate to chat id is %d, want %d", test.name, apiError.MigrateToChatID(), test.migrateTo)
+		}
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+	})
+
+	// Errors are returned by the methods that don't send messages as well
+	_, err := bot.GetChat(int64(5))
+	if !errors.Is(err, ErrChatNotFound) {
+		t.Fatalf("returned error is %v, want %v", err, ErrChatNotFound)
+	}
+
+	if err.Error() != "telegram api error 400: Bad Request: chat not found" {
+		t.Errorf("error message is %q", err.Error())
+	}
+}
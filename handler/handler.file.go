@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+	"github.com/Benyam-S/go-tg-bot/log"
+)
+
+// DefaultMaxDownloadSize is the maximum size of a file that can be downloaded from the telegram bot api
+const DefaultMaxDownloadSize int64 = 20 << 20
+
+// ErrFileTooLarge is returned when a file to be downloaded exceeds the maximum download size
+var ErrFileTooLarge = errors.New("file is too large to be downloaded")
+
+// SetMaxDownloadSize is a method that sets the maximum size of the files downloaded by DownloadFile
+/* Zero restores DefaultMaxDownloadSize and a negative size disables the limit, which is useful for */
+/* local Bot API servers that don't have the 20 MB limit */
+func (handler *TelegramBotHandler) SetMaxDownloadSize(size int64) {
+	handler.maxDownloadSize = size
+}
+
+// GetFile gets the basic information about a file and prepares it for downloading
+/* The file can be downloaded for at least 1 hour using the file path of the returned File */
+func (handler *TelegramBotHandler) GetFile(fileID string) (*entity.FileResponse, error) {
+	return handler.GetFileCtx(context.Background(), fileID)
+}
+
+// GetFileCtx is the context aware version of GetFile
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) GetFileCtx(ctx context.Context, fileID string) (*entity.FileResponse, error) {
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started getting file { File ID : %s }", fileID), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/getFile?file_id=" +
+		url.QueryEscape(fileID)
+	response, err := handler.get(ctx, telegramAPI)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting file { File ID : %s }, %s",
+			fileID, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.FileResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting file, unable to parse response "+
+			"{ File ID : %s }, %s", fileID, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting file, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished getting file, Bot Response => %s", botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// FileURL is a method that returns the url for downloading the file found at the file path
+/* The url is built from the bot api access point, so custom Bot API servers are supported. The url contains */
+/* the bot access token, so it shouldn't be shared or logged */
+func (handler *TelegramBotHandler) FileURL(filePath string) string {
+
+	// The access point ends with '/bot', like 'https://api.telegram.org/bot', while files are served from '/file/bot'
+	accessPoint := strings.TrimSuffix(handler.BotAPIAccessPoint, "bot")
+	if !strings.HasSuffix(accessPoint, "/") {
+		accessPoint += "/"
+	}
+
+	return accessPoint + "file/bot" + handler.BotAccessToken + "/" + strings.TrimPrefix(filePath, "/")
+}
+
+// DownloadFile downloads the file identified by its file id and writes its content to the writer
+/* It returns the number of bytes written. Files larger than the maximum download size are not downloaded */
+func (handler *TelegramBotHandler) DownloadFile(fileID string, writer io.Writer) (int64, error) {
+	return handler.DownloadFileCtx(context.Background(), fileID, writer)
+}
+
+// DownloadFileCtx is the context aware version of DownloadFile
+/* The context is used for cancelling the download and setting its deadline, the content is streamed so a */
+/* cancelled download can leave a partial content in the writer */
+func (handler *TelegramBotHandler) DownloadFileCtx(ctx context.Context, fileID string,
+	writer io.Writer) (int64, error) {
+
+	botResponse, err := handler.GetFileCtx(ctx, fileID)
+	if err != nil {
+		return 0, err
+	}
+
+	return handler.DownloadFileContentCtx(ctx, &botResponse.Result, writer)
+}
+
+// DownloadFileContentCtx downloads the file returned by GetFile and writes its content to the writer
+/* If the file path is an absolute path, as returned by local Bot API servers, the file is read from the disk */
+func (handler *TelegramBotHandler) DownloadFileContentCtx(ctx context.Context, file *entity.File,
+	writer io.Writer) (int64, error) {
+
+	limit := handler.maxDownloadSize
+	if limit == 0 {
+		limit = DefaultMaxDownloadSize
+	}
+
+	if file.FilePath == "" {
+		return 0, errors.New("file path is not available, the file can't be downloaded")
+	}
+
+	if limit > 0 && file.FileSize > limit {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For downloading file { File ID : %s, File Path : %s, File Size : %d }, %s",
+			file.FileID, file.FilePath, file.FileSize, ErrFileTooLarge.Error()), log.ErrorLogFile)
+
+		return 0, ErrFileTooLarge
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started downloading file { File ID : %s, File Path : %s, File Size : %d }",
+		file.FileID, file.FilePath, file.FileSize), log.BotLogFile)
+
+	content, err := handler.openFile(ctx, file.FilePath)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For downloading file { File ID : %s, File Path : %s }, %s",
+			file.FileID, file.FilePath, err.Error()), log.ErrorLogFile)
+
+		return 0, err
+	}
+	defer content.Close()
+
+	var reader io.Reader = content
+	if limit > 0 {
+		// Reading one more byte than the limit, so files exceeding it can be detected
+		reader = io.LimitReader(content, limit+1)
+	}
+
+	written, err := io.Copy(writer, reader)
+	if err == nil && limit > 0 && written > limit {
+		err = ErrFileTooLarge
+	}
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For downloading file { File ID : %s, File Path : %s }, %s",
+			file.FileID, file.FilePath, err.Error()), log.ErrorLogFile)
+
+		return written, err
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished downloading file { File ID : %s, File Path : %s, Bytes Written : %d }",
+		file.FileID, file.FilePath, written), log.BotLogFile)
+
+	return written, nil
+}
+
+// openFile is a method that returns a reader of the content of the file found at the file path
+func (handler *TelegramBotHandler) openFile(ctx context.Context, filePath string) (io.ReadCloser, error) {
+
+	// Local Bot API servers return absolute paths of files stored on the same machine
+	if filepath.IsAbs(filePath) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return &contextReader{ctx: ctx, ReadCloser: file}, nil
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, handler.FileURL(filePath), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := handler.HTTPClient().Do(request)
+	if err != nil {
+		// The error contains the url, which has the bot access token
+		if urlError, ok := err.(*url.Error); ok {
+			return nil, urlError.Err
+		}
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+
+		status := new(apiResponseStatus)
+		if json.NewDecoder(response.Body).Decode(status) == nil && status.ErrorCode != 0 {
+			return nil, newAPIError(status.ErrorCode, status.Description, status.Parameters)
+		}
+
+		return nil, newAPIError(int64(response.StatusCode), http.StatusText(response.StatusCode),
+			entity.ResponseParameters{})
+	}
+
+	return response.Body, nil
+}
+
+// contextReader is a type that stops reading once its context is done, used for files read from the disk
+type contextReader struct {
+	io.ReadCloser
+	ctx context.Context
+}
+
+// Read is a method that reads from the underlying reader, failing with the context error once it is done
+func (reader *contextReader) Read(p []byte) (int, error) {
+	if err := reader.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return reader.ReadCloser.Read(p)
+}
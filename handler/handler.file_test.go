@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// fileAPI is a function that serves getFile requests and the file contents like the telegram file server
+func fileAPI(w http.ResponseWriter, r *http.Request) {
+
+	switch r.URL.Path {
+	case "/botTOKEN/getFile":
+		w.Write([]byte(`{"ok":true,"result":{"file_id":"` + r.FormValue("file_id") +
+			`","file_path":"documents/file_1.txt"}}`))
+	case "/file/botTOKEN/documents/file_1.txt":
+		w.Write([]byte("CONTENT"))
+	case "/file/botTOKEN/slow.txt":
+		w.Write([]byte("C"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"ok":false,"error_code":404,"description":"Not Found"}`))
+	}
+}
+
+func TestDownloadFileContent(t *testing.T) {
+
+	bot := newTestBot(t, fileAPI)
+	ctx := context.Background()
+
+	var content bytes.Buffer
+	written, err := bot.DownloadFileContentCtx(ctx, &entity.File{FileID: "1", FilePath: "documents/file_1.txt"},
+		&content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if written != 7 || content.String() != "CONTENT" {
+		t.Errorf("downloaded %d bytes %q, want 7 bytes %q", written, content.String(), "CONTENT")
+	}
+
+	// Files can also be downloaded directly using their file id
+	content.Reset()
+	if _, err = bot.DownloadFileCtx(ctx, "1", &content); err != nil || content.String() != "CONTENT" {
+		t.Errorf("downloaded %q with error %v, want %q", content.String(), err, "CONTENT")
+	}
+}
+
+func TestDownloadFileContentLocalPath(t *testing.T) {
+
+	bot := newTestBot(t, fileAPI)
+
+	// Local Bot API servers return absolute paths, which are read from the disk
+	filePath := filepath.Join(t.TempDir(), "file_1.txt")
+	if err := ioutil.WriteFile(filePath, []byte("LOCAL"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var content bytes.Buffer
+	_, err := bot.DownloadFileContentCtx(context.Background(), &entity.File{FileID: "1", FilePath: filePath},
+		&content)
+	if err != nil || content.String() != "LOCAL" {
+		t.Errorf("downloaded %q with error %v, want %q", content.String(), err, "LOCAL")
+	}
+}
+
+func TestDownloadFileContentLocalPathCancel(t *testing.T) {
+
+	bot := newTestBot(t, fileAPI)
+
+	filePath := filepath.Join(t.TempDir(), "file_1.txt")
+	if err := ioutil.WriteFile(filePath, []byte("LOCAL"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var content bytes.Buffer
+	_, err := bot.DownloadFileContentCtx(ctx, &entity.File{FileID: "1", FilePath: filePath}, &content)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("downloading with a cancelled context returned %v, want %v", err, context.Canceled)
+	}
+
+	// Files that are already open stop being read once the context is done
+	ctx, cancel = context.WithCancel(context.Background())
+	reader, err := bot.openFile(ctx, filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	buffer := make([]byte, 2)
+	if _, err = reader.Read(buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	cancel()
+	if _, err = reader.Read(buffer); !errors.Is(err, context.Canceled) {
+		t.Errorf("reading after cancelling returned %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestDownloadFileContentErrors(t *testing.T) {
+
+	bot := newTestBot(t, fileAPI)
+	ctx := context.Background()
+
+	var content bytes.Buffer
+	if _, err := bot.DownloadFileContentCtx(ctx, &entity.File{FileID: "1"}, &content); err == nil {
+		t.Error("expected an error for a file without a file path")
+	}
+
+	_, err := bot.DownloadFileContentCtx(ctx, &entity.File{FileID: "1", FilePath: "missing.txt"}, &content)
+	apiError := new(APIError)
+	if !errors.As(err, &apiError) || apiError.ErrorCode != http.StatusNotFound {
+		t.Errorf("downloading a missing file returned %v, want a not found api error", err)
+	}
+
+	// Files known to be larger than the limit aren't requested, the others are stopped once they exceed it
+	bot.SetMaxDownloadSize(5)
+	_, err = bot.DownloadFileContentCtx(ctx, &entity.File{FileID: "1", FilePath: "documents/file_1.txt",
+		FileSize: 7}, &content)
+	if err != ErrFileTooLarge {
+		t.Errorf("downloading a file with a large file size returned %v, want %v", err, ErrFileTooLarge)
+	}
+
+	content.Reset()
+	written, err := bot.DownloadFileContentCtx(ctx, &entity.File{FileID: "1", FilePath: "documents/file_1.txt"},
+		&content)
+	if err != ErrFileTooLarge || written != 6 {
+		t.Errorf("downloading a large file returned %d bytes and %v, want 6 bytes and %v", written, err,
+			ErrFileTooLarge)
+	}
+}
+
+func TestDownloadFileContentCancel(t *testing.T) {
+
+	bot := newTestBot(t, fileAPI)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var content bytes.Buffer
+	start := time.Now()
+	_, err := bot.DownloadFileContentCtx(ctx, &entity.File{FileID: "1", FilePath: "slow.txt"}, &content)
+	if err == nil {
+		t.Error("expected an error for a cancelled download")
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("cancelled download returned after %s", elapsed)
+	}
+}
@@ -0,0 +1,345 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+	"github.com/Benyam-S/go-tg-bot/log"
+)
+
+// SendGameToTelegramChat sends a game to the Telegram chat identified by its chat ID
+/* The game short name is the one set up for the game using BotFather. If a reply markup is provided, its first */
+/* button must be a callback game button, otherwise a button for playing the game is added by telegram */
+/* Available Optional Values */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendGameToTelegramChat(chatID interface{}, gameShortName string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendGameToTelegramChatCtx(context.Background(), chatID, gameShortName, optionals)
+}
+
+// SendGameToTelegramChatCtx is the context aware version of SendGameToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendGameToTelegramChatCtx(ctx context.Context, chatID interface{},
+	gameShortName string, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	replyMarkup := ""
+	chatIDS := ""
+
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending game to telegram chat { Chat ID : %s, Game Short Name : %s, "+
+		"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+		"Reply Markup : %s }",
+		chatIDS, gameShortName, disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply,
+		replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendGame"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                     {chatIDS},
+			"game_short_name":             {gameShortName},
+			"disable_notification":        {strconv.FormatBool(disableNotification)},
+			"protect_content":             {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":         {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply": {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                {replyMarkup},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending game to telegram chat { Chat ID : %s, Game Short Name : %s, "+
+			"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, gameShortName, disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply,
+			replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending game to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Game Short Name : %s, Disable Notification : %v, Protect Content : %v, "+
+			"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, gameShortName, disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply,
+			replyMarkup, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending game to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending game to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// SetGameScore sets the score of the user in the game message identified by its (chat ID and message ID) or inline message id
+/* Only user id and score are required because (chat ID and message ID) or inline message id are interchangable, */
+/* if one is available it works. Unless force is set, the score can't be lower than the current score of the user */
+/* Available Optional Values */
+/* ChatID                      interface{} */
+/* MessageID                   int64 */
+/* InlineMessageID             string */
+/* Force                       bool */
+/* DisableEditMessage          bool */
+func (handler *TelegramBotHandler) SetGameScore(userID int64, score int64,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SetGameScoreCtx(context.Background(), userID, score, optionals)
+}
+
+// SetGameScoreCtx is the context aware version of SetGameScore
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SetGameScoreCtx(ctx context.Context, userID int64, score int64,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	chatID := ""
+	inlineMessageID := ""
+
+	var messageID int64
+	var force bool
+	var disableEditMessage bool
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if id, ok := optionals.ChatID.(int64); ok {
+			chatID = strconv.FormatInt(id, 10)
+		} else if id, ok := optionals.ChatID.(string); ok {
+			chatID = id
+		} else if optionals.ChatID == nil {
+			// Since chatID can be empty
+			chatID = ""
+		} else {
+			return nil, errors.New("chat id can only be type string or integer")
+		}
+
+		messageID = optionals.MessageID
+		inlineMessageID = optionals.InlineMessageID
+		force = optionals.Force
+		disableEditMessage = optionals.DisableEditMessage
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started setting game score { Chat ID : %s, User ID : %d, Score : %d, "+
+		"Message ID : %d, Inline Message ID : %s, Force : %v, Disable Edit Message : %v }",
+		chatID, userID, score, messageID, inlineMessageID, force, disableEditMessage), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/setGameScore"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":              {chatID},
+			"user_id":              {strconv.FormatInt(userID, 10)},
+			"score":                {strconv.FormatInt(score, 10)},
+			"message_id":           {strconv.FormatInt(messageID, 10)},
+			"inline_message_id":    {inlineMessageID},
+			"force":                {strconv.FormatBool(force)},
+			"disable_edit_message": {strconv.FormatBool(disableEditMessage)},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For setting game score { Chat ID : %s, User ID : %d, Score : %d, "+
+			"Message ID : %d, Inline Message ID : %s, Force : %v, Disable Edit Message : %v }, %s",
+			chatID, userID, score, messageID, inlineMessageID, force, disableEditMessage, err.Error()),
+			log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For setting game score, unable to parse response { Chat ID : %s, "+
+			"User ID : %d, Score : %d, Message ID : %d, Inline Message ID : %s, Force : %v, "+
+			"Disable Edit Message : %v }, %s",
+			chatID, userID, score, messageID, inlineMessageID, force, disableEditMessage, err.Error()),
+			log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For setting game score, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished setting game score, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// GetGameHighScores gets the high scores of the user and its neighbours in the game message identified by its (chat ID and message ID) or inline message id
+/* Only user id is required because (chat ID and message ID) or inline message id are interchangable, if one is available it works */
+/* Available Optional Values */
+/* ChatID                      interface{} */
+/* MessageID                   int64 */
+/* InlineMessageID             string */
+func (handler *TelegramBotHandler) GetGameHighScores(userID int64,
+	optionals *entity.Optional) (*entity.GameHighScoresResponse, error) {
+	return handler.GetGameHighScoresCtx(context.Background(), userID, optionals)
+}
+
+// GetGameHighScoresCtx is the context aware version of GetGameHighScores
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) GetGameHighScoresCtx(ctx context.Context, userID int64,
+	optionals *entity.Optional) (*entity.GameHighScoresResponse, error) {
+
+	chatID := ""
+	inlineMessageID := ""
+
+	var messageID int64
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if id, ok := optionals.ChatID.(int64); ok {
+			chatID = strconv.FormatInt(id, 10)
+		} else if id, ok := optionals.ChatID.(string); ok {
+			chatID = id
+		} else if optionals.ChatID == nil {
+			// Since chatID can be empty
+			chatID = ""
+		} else {
+			return nil, errors.New("chat id can only be type string or integer")
+		}
+
+		messageID = optionals.MessageID
+		inlineMessageID = optionals.InlineMessageID
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started getting game high scores { Chat ID : %s, User ID : %d, Message ID : %d, "+
+		"Inline Message ID : %s }",
+		chatID, userID, messageID, inlineMessageID), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/getGameHighScores"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":           {chatID},
+			"user_id":           {strconv.FormatInt(userID, 10)},
+			"message_id":        {strconv.FormatInt(messageID, 10)},
+			"inline_message_id": {inlineMessageID},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting game high scores { Chat ID : %s, User ID : %d, "+
+			"Message ID : %d, Inline Message ID : %s }, %s",
+			chatID, userID, messageID, inlineMessageID, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.GameHighScoresResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting game high scores, unable to parse response { Chat ID : %s, "+
+			"User ID : %d, Message ID : %d, Inline Message ID : %s }, %s",
+			chatID, userID, messageID, inlineMessageID, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting game high scores, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished getting game high scores, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// AnswerToTelegramGameCallBack answers the callback query of a callback game button with the url of the game
+/* The url opens the game in the in-app browser of the user, the game short name of the query can be used for */
+/* choosing the url. An error is returned without answering if the query isn't from a callback game button */
+/* Available Optional Values */
+/* Text                     string */
+/* ShowAlert                bool */
+/* CacheTime                int64 */
+func (handler *TelegramBotHandler) AnswerToTelegramGameCallBack(query *entity.CallbackQuery, gameURL string,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.AnswerToTelegramGameCallBackCtx(context.Background(), query, gameURL, optionals)
+}
+
+// AnswerToTelegramGameCallBackCtx is the context aware version of AnswerToTelegramGameCallBack
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) AnswerToTelegramGameCallBackCtx(ctx context.Context, query *entity.CallbackQuery,
+	gameURL string, optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	if query == nil || query.GameShortName == "" {
+		return nil, errors.New("callback query isn't from a callback game button")
+	}
+
+	if gameURL == "" {
+		return nil, errors.New("game url is required for answering a game callback query")
+	}
+
+	// The optionals are copied so the url of the game doesn't change the given optionals
+	answer := entity.Optional{}
+	if optionals != nil {
+		answer.Text = optionals.Text
+		answer.ShowAlert = optionals.ShowAlert
+		answer.CacheTime = optionals.CacheTime
+	}
+	answer.URL = gameURL
+
+	return handler.AnswerToTelegramCallBackCtx(ctx, query.ID, &answer)
+}
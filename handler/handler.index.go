@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"net/http"
 	"strings"
 
 	"github.com/Benyam-S/go-tg-bot/log"
@@ -15,6 +16,10 @@ type TelegramBotHandler struct {
 	BotURL            string
 	logger            log.ILogger
 	logs              *log.LogContainer // logs can never be nil
+	client            *http.Client      // client used for sending requests, http.DefaultClient if nil
+	retryPolicy       *RetryPolicy      // retrying is disabled if nil
+	rateLimiter       *RateLimiter      // rate limiting is disabled if nil
+	maxDownloadSize   int64             // DefaultMaxDownloadSize if zero, no limit if negative
 }
 
 // NewTelegramBotHandler is a function that returns a new telegram bot handler
@@ -37,16 +42,60 @@ func NewTelegramBotHandler(botAPIAccessPoint string, botAccessToken string, botI
 		BotID: botID, BotURL: botURL, BotUsername: botUsername, logger: botLogger, logs: botLogs}
 }
 
+// NewTelegramBotHandlerWithClient is a function that returns a new telegram bot handler that uses the given http client
+/* The client can be used for setting timeouts, proxies, custom TLS configurations or connection pool limits */
+func NewTelegramBotHandlerWithClient(botAPIAccessPoint string, botAccessToken string, botID int64, botUsername string,
+	botLogger log.ILogger, botLogs *log.LogContainer, client *http.Client) *TelegramBotHandler {
+
+	handler := NewTelegramBotHandler(botAPIAccessPoint, botAccessToken, botID, botUsername, botLogger, botLogs)
+	handler.client = client
+
+	return handler
+}
+
+// SetHTTPClient is a method that sets the http client used for sending all the requests to the telegram api
+/* Setting a nil client restores the default client, it should be set before the handler is used */
+func (handler *TelegramBotHandler) SetHTTPClient(client *http.Client) {
+	handler.client = client
+}
+
+// SetTransport is a method that sets the round tripper used for sending all the requests to the telegram api
+/* The timeout of the current client is kept, it should be set before the handler is used */
+func (handler *TelegramBotHandler) SetTransport(transport http.RoundTripper) {
+	client := &http.Client{Transport: transport}
+	if handler.client != nil {
+		client.Timeout = handler.client.Timeout
+		client.CheckRedirect = handler.client.CheckRedirect
+		client.Jar = handler.client.Jar
+	}
+
+	handler.client = client
+}
+
+// HTTPClient is a method that returns the http client used for sending requests to the telegram api
+func (handler *TelegramBotHandler) HTTPClient() *http.Client {
+	if handler.client == nil {
+		return http.DefaultClient
+	}
+
+	return handler.client
+}
+
 // Logging is a method that will be internally used for making logging efficient
 func (handler *TelegramBotHandler) Logging(stmt, logFile string) {
-	if handler.logger != nil {
-		if handler.logs != nil {
+	logging(handler.logger, handler.logs, stmt, logFile)
+}
+
+// logging is a function that logs the statement to the log file selected from the log container
+func logging(logger log.ILogger, logs *log.LogContainer, stmt, logFile string) {
+	if logger != nil {
+		if logs != nil {
 			if logFile == log.ErrorLogFile {
-				logFile = handler.logs.ErrorLogFile
+				logFile = logs.ErrorLogFile
 			} else {
-				logFile = handler.logs.BotLogFile
+				logFile = logs.BotLogFile
 			}
 		}
-		handler.logger.Log(stmt, logFile)
+		logger.Log(stmt, logFile)
 	}
 }
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned by a fail-fast rate limiter when a request would exceed one of the budgets
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// maxIdleBuckets is the number of chat buckets after which the idle buckets are removed
+const maxIdleBuckets = 1000
+
+// RateLimiter is a type that paces the outgoing messages so they stay within telegram's limits
+/* A global budget applies to all the messages, a per chat budget applies to every chat and an additional */
+/* per group budget applies to groups and channels. It is safe for concurrent use */
+type RateLimiter struct {
+	mu       sync.Mutex
+	failFast bool
+
+	globalRate float64 // messages per second
+	chatRate   float64 // messages per second
+	groupRate  float64 // messages per second
+
+	global *bucket
+	chats  map[string]*bucket
+	groups map[string]*bucket
+}
+
+// bucket is a type that implements a token bucket, the tokens can go negative for reserving future tokens
+type bucket struct {
+	tokens   float64
+	capacity float64
+	rate     float64 // tokens per second
+	last     time.Time
+}
+
+// NewRateLimiter is a function that returns a new rate limiter with the given budgets, zero disables a budget
+/* If fail fast is true requests exceeding a budget fail with ErrRateLimited instead of waiting */
+func NewRateLimiter(globalPerSecond, chatPerSecond, groupPerMinute float64, failFast bool) *RateLimiter {
+
+	limiter := &RateLimiter{failFast: failFast, globalRate: globalPerSecond, chatRate: chatPerSecond,
+		groupRate: groupPerMinute / 60, chats: make(map[string]*bucket), groups: make(map[string]*bucket)}
+
+	if globalPerSecond > 0 {
+		limiter.global = newBucket(globalPerSecond, globalPerSecond)
+	}
+
+	return limiter
+}
+
+// NewDefaultRateLimiter is a function that returns a new blocking rate limiter using telegram's documented limits
+/* 30 messages per second globally, 1 message per second per chat and 20 messages per minute per group */
+func NewDefaultRateLimiter() *RateLimiter {
+	return NewRateLimiter(30, 1, 20, false)
+}
+
+// SetRateLimiter is a method that sets the rate limiter used for the message sending methods, nil disables it
+/* It should be set before the handler is used */
+func (handler *TelegramBotHandler) SetRateLimiter(limiter *RateLimiter) {
+	handler.rateLimiter = limiter
+}
+
+// Wait is a method that blocks until a message can be sent to the chat or the context is done
+/* In fail fast mode it returns ErrRateLimited immediately if the message can't be sent right away */
+func (limiter *RateLimiter) Wait(ctx context.Context, chatID string) error {
+
+	limiter.mu.Lock()
+
+	now := time.Now()
+	buckets := limiter.buckets(chatID, now)
+
+	var delay time.Duration
+	for _, bucket := range buckets {
+		bucket.refill(now)
+		if wait := bucket.delay(); wait > delay {
+			delay = wait
+		}
+	}
+
+	if delay > 0 && limiter.failFast {
+		limiter.mu.Unlock()
+		return ErrRateLimited
+	}
+
+	// Reserving the tokens now, so concurrent callers queue behind this one
+	for _, bucket := range buckets {
+		bucket.tokens--
+	}
+
+	limiter.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Giving back the reserved tokens, since the message won't be sent
+		limiter.mu.Lock()
+		for _, bucket := range buckets {
+			bucket.tokens++
+		}
+		limiter.mu.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// buckets is a method that returns the buckets that apply to the chat, creating the missing ones
+func (limiter *RateLimiter) buckets(chatID string, now time.Time) []*bucket {
+
+	buckets := make([]*bucket, 0, 3)
+	if limiter.global != nil {
+		buckets = append(buckets, limiter.global)
+	}
+
+	if chatID == "" {
+		return buckets
+	}
+
+	if limiter.chatRate > 0 {
+		buckets = append(buckets, limiter.chatBucket(limiter.chats, chatID, limiter.chatRate, 1, now))
+	}
+
+	// Groups, supergroups and channels have negative ids or are identified by their username
+	if limiter.groupRate > 0 && (strings.HasPrefix(chatID, "-") || strings.HasPrefix(chatID, "@")) {
+		buckets = append(buckets, limiter.chatBucket(limiter.groups, chatID, limiter.groupRate,
+			limiter.groupRate*60, now))
+	}
+
+	return buckets
+}
+
+// chatBucket is a method that returns the bucket of the chat from the given buckets, creating it if missing
+func (limiter *RateLimiter) chatBucket(buckets map[string]*bucket, chatID string, rate, capacity float64,
+	now time.Time) *bucket {
+
+	chatBucket, ok := buckets[chatID]
+	if ok {
+		return chatBucket
+	}
+
+	// Removing the buckets that have been idle long enough to be full again, so memory stays bounded
+	if len(buckets) >= maxIdleBuckets {
+		for id, idle := range buckets {
+			idle.refill(now)
+			if idle.tokens >= idle.capacity {
+				delete(buckets, id)
+			}
+		}
+	}
+
+	chatBucket = newBucket(rate, capacity)
+	buckets[chatID] = chatBucket
+
+	return chatBucket
+}
+
+// newBucket is a function that returns a new full token bucket
+func newBucket(rate, capacity float64) *bucket {
+	return &bucket{tokens: capacity, capacity: capacity, rate: rate, last: time.Now()}
+}
+
+// refill is a method that adds the tokens produced since the last refill
+func (bucket *bucket) refill(now time.Time) {
+
+	elapsed := now.Sub(bucket.last).Seconds()
+	if elapsed > 0 {
+		bucket.tokens += elapsed * bucket.rate
+		if bucket.tokens > bucket.capacity {
+			bucket.tokens = bucket.capacity
+		}
+		bucket.last = now
+	}
+}
+
+// delay is a method that returns the time to wait until a token is available
+func (bucket *bucket) delay() time.Duration {
+
+	if bucket.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - bucket.tokens) / bucket.rate * float64(time.Second))
+}
+
+// limitedMethod is a function that checks if the telegram api method sends messages and should be rate limited
+func limitedMethod(method string) bool {
+	return strings.HasPrefix(method, "send") || method == "forwardMessage" || method == "copyMessage"
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterFailFast(t *testing.T) {
+
+	limiter := NewRateLimiter(30, 1, 20, true)
+	ctx := context.Background()
+
+	if err := limiter.Wait(ctx, "5"); err != nil {
+		t.Fatalf("first message to the chat returned %v", err)
+	}
+
+	// The per chat budget allows a single message per second
+	if err := limiter.Wait(ctx, "5"); err != ErrRateLimited {
+		t.Errorf("second message to the chat returned %v, want %v", err, ErrRateLimited)
+	}
+
+	if err := limiter.Wait(ctx, "6"); err != nil {
+		t.Errorf("message to another chat returned %v", err)
+	}
+}
+
+func TestRateLimiterGlobalBudget(t *testing.T) {
+
+	limiter := NewRateLimiter(3, 0, 0, true)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx, ""); err != nil {
+			t.Fatalf("message %d returned %v", i+1, err)
+		}
+	}
+
+	if err := limiter.Wait(ctx, ""); err != ErrRateLimited {
+		t.Errorf("message exceeding the global budget returned %v, want %v", err, ErrRateLimited)
+	}
+}
+
+func TestRateLimiterGroupBudget(t *testing.T) {
+
+	limiter := NewRateLimiter(0, 0, 2, true)
+	ctx := context.Background()
+
+	for _, chatID := range []string{"-5", "-5", "7", "7", "7"} {
+		if err := limiter.Wait(ctx, chatID); err != nil {
+			t.Fatalf("message to chat %s returned %v", chatID, err)
+		}
+	}
+
+	// Only groups, supergroups and channels are limited by the per group budget
+	if err := limiter.Wait(ctx, "-5"); err != ErrRateLimited {
+		t.Errorf("message exceeding the group budget returned %v, want %v", err, ErrRateLimited)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+
+	limiter := NewRateLimiter(0, 20, 0, false)
+	ctx := context.Background()
+
+	// The first message uses the available token, the others have to wait 50ms each
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := limiter.Wait(ctx, "5"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("3 messages were allowed within %s, want at least 100ms", elapsed)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := limiter.Wait(cancelled, "5"); !errors.Is(err, context.Canceled) {
+		t.Errorf("waiting with a cancelled context returned %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRateLimiterSendMethods(t *testing.T) {
+
+	requests := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"ok":true,"result":{"message_id":9,"id":8}}`))
+	})
+	bot.SetRateLimiter(NewRateLimiter(0, 1, 0, true))
+
+	if _, err := bot.SendReplyToTelegramChat(int64(8), "hello", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := bot.SendReplyToTelegramChat(int64(8), "hello", nil); err != ErrRateLimited {
+		t.Errorf("second message to the chat returned %v, want %v", err, ErrRateLimited)
+	}
+
+	// Methods that don't send messages aren't limited
+	if _, err := bot.GetChat(int64(8)); err != nil {
+		t.Errorf("getting the chat returned %v", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("sent %d requests, want 2", requests)
+	}
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// maxMediaGroupSize is the maximum number of items telegram allows in an album
+const maxMediaGroupSize = 10
+
+// MediaGroup is a type that holds all the messages of an album received as separate updates
+type MediaGroup struct {
+	ID       string
+	ChatID   int64
+	Messages []*entity.Message // ordered by message id
+}
+
+// MediaGroupCollector is a type that buffers the messages sharing a media group id and emits them as one group
+/* A group is emitted once no new item is received for the quiet period, or right away when it has 10 items. */
+/* The groups are keyed by chat, and when the maximum number of pending groups is reached the oldest one is */
+/* emitted early so the memory stays bounded. It is safe for concurrent use */
+type MediaGroupCollector struct {
+	quietPeriod time.Duration
+	maxGroups   int
+	callback    func(group *MediaGroup)
+
+	mu      sync.Mutex
+	pending map[string]*pendingMediaGroup
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// pendingMediaGroup is a type that holds a media group that is still receiving items
+type pendingMediaGroup struct {
+	group   *MediaGroup
+	created time.Time
+	timer   *time.Timer
+	seq     int64 // identifies the latest timer, so stale timers are ignored
+}
+
+// NewMediaGroupCollector is a function that returns a new media group collector
+/* The callback is called from its own goroutine for every completed group, a zero max groups means no limit. */
+/* A nil callback drops the completed groups */
+func NewMediaGroupCollector(quietPeriod time.Duration, maxGroups int,
+	callback func(group *MediaGroup)) *MediaGroupCollector {
+
+	if callback == nil {
+		callback = func(group *MediaGroup) {}
+	}
+
+	return &MediaGroupCollector{quietPeriod: quietPeriod, maxGroups: maxGroups, callback: callback,
+		pending: make(map[string]*pendingMediaGroup)}
+}
+
+// Add is a method that buffers the message if it is part of a media group
+/* It returns false without buffering if the message doesn't have a media group id or the collector is stopped */
+func (collector *MediaGroupCollector) Add(message *entity.Message) bool {
+
+	if message == nil || message.MediaGroupID == "" {
+		return false
+	}
+
+	key := strconv.FormatInt(message.Chat.ID, 10) + ":" + message.MediaGroupID
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	if collector.stopped {
+		return false
+	}
+
+	pending, ok := collector.pending[key]
+	if !ok {
+		if collector.maxGroups > 0 && len(collector.pending) >= collector.maxGroups {
+			collector.emitOldest()
+		}
+
+		pending = &pendingMediaGroup{group: &MediaGroup{ID: message.MediaGroupID, ChatID: message.Chat.ID},
+			created: time.Now()}
+		collector.pending[key] = pending
+	}
+
+	pending.group.Messages = append(pending.group.Messages, message)
+	if len(pending.group.Messages) >= maxMediaGroupSize {
+		collector.emit(key)
+		return true
+	}
+
+	// Restarting the quiet period
+	if pending.timer != nil {
+		pending.timer.Stop()
+	}
+	pending.seq++
+	seq := pending.seq
+	pending.timer = time.AfterFunc(collector.quietPeriod, func() {
+		collector.mu.Lock()
+		defer collector.mu.Unlock()
+
+		if collector.stopped {
+			return
+		}
+
+		if current, ok := collector.pending[key]; ok && current.seq == seq {
+			collector.emit(key)
+		}
+	})
+
+	return true
+}
+
+// Flush is a method that emits all the pending groups without waiting for their quiet period
+func (collector *MediaGroupCollector) Flush() {
+
+	collector.mu.Lock()
+	for key := range collector.pending {
+		collector.emit(key)
+	}
+	collector.mu.Unlock()
+}
+
+// Stop is a method that flushes all the pending groups and waits for the running callbacks to return
+/* Once stopped, the collector doesn't buffer any new message */
+func (collector *MediaGroupCollector) Stop() {
+
+	// Flushing and stopping under the same lock, so no group can be emitted after waiting has started
+	collector.mu.Lock()
+	collector.stopped = true
+	for key := range collector.pending {
+		collector.emit(key)
+	}
+	collector.mu.Unlock()
+
+	collector.wg.Wait()
+}
+
+// Pending is a method that returns the number of groups that are still receiving items
+func (collector *MediaGroupCollector) Pending() int {
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	return len(collector.pending)
+}
+
+// Middleware is a method that returns a dispatcher middleware that passes the album items to the collector
+/* New messages and channel posts that are part of a media group are buffered instead of being handled one */
+/* by one, all the other updates reach the next handlers as they are */
+func (collector *MediaGroupCollector) Middleware() Middleware {
+	return func(next UpdateHandlerFunc) UpdateHandlerFunc {
+		return func(ctx *UpdateContext) error {
+
+			updateType := ctx.Update.Type()
+			if updateType == entity.UpdateTypeMessage || updateType == entity.UpdateTypeChannelPost {
+				if collector.Add(ctx.Message()) {
+					return nil
+				}
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// emitOldest is a method that emits the pending group that was created first, it should be called holding the lock
+func (collector *MediaGroupCollector) emitOldest() {
+
+	oldestKey := ""
+	var oldest time.Time
+
+	for key, pending := range collector.pending {
+		if oldestKey == "" || pending.created.Before(oldest) {
+			oldestKey = key
+			oldest = pending.created
+		}
+	}
+
+	if oldestKey != "" {
+		collector.emit(oldestKey)
+	}
+}
+
+// emit is a method that removes the pending group and passes it to the callback, it should be called holding the lock
+func (collector *MediaGroupCollector) emit(key string) {
+
+	pending := collector.pending[key]
+	delete(collector.pending, key)
+
+	if pending.timer != nil {
+		pending.timer.Stop()
+	}
+
+	group := pending.group
+	sort.SliceStable(group.Messages, func(i, j int) bool {
+		return group.Messages[i].MessageID < group.Messages[j].MessageID
+	})
+
+	collector.wg.Add(1)
+	go func() {
+		defer collector.wg.Done()
+		collector.callback(group)
+	}()
+}
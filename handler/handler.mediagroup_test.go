@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// newAlbumMessage is a function that returns a message that is part of the media group
+func newAlbumMessage(messageID int64, mediaGroupID string) *entity.Message {
+	return &entity.Message{MessageID: messageID, MediaGroupID: mediaGroupID, Chat: entity.Chat{ID: 3}}
+}
+
+func TestMediaGroupCollector(t *testing.T) {
+
+	groups := make(chan *MediaGroup, 2)
+	collector := NewMediaGroupCollector(20*time.Millisecond, 0, func(group *MediaGroup) { groups <- group })
+
+	if collector.Add(&entity.Message{MessageID: 1}) {
+		t.Error("message without a media group id was buffered")
+	}
+
+	for _, messageID := range []int64{12, 10, 11} {
+		if !collector.Add(newAlbumMessage(messageID, "album")) {
+			t.Fatalf("message %d wasn't buffered", messageID)
+		}
+	}
+
+	select {
+	case group := <-groups:
+		if group.ID != "album" || len(group.Messages) != 3 || group.Messages[0].MessageID != 10 ||
+			group.Messages[2].MessageID != 12 {
+			t.Errorf("emitted group %s with %d messages, want the 3 album messages in order", group.ID,
+				len(group.Messages))
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("group wasn't emitted after the quiet period")
+	}
+
+	collector.Stop()
+}
+
+func TestMediaGroupCollectorStop(t *testing.T) {
+
+	var mu sync.Mutex
+	emitted := 0
+	collector := NewMediaGroupCollector(time.Hour, 0, func(group *MediaGroup) {
+		mu.Lock()
+		emitted += len(group.Messages)
+		mu.Unlock()
+	})
+
+	collector.Add(newAlbumMessage(1, "album"))
+	collector.Add(newAlbumMessage(2, "album"))
+
+	// Stopping flushes the pending groups and waits for their callbacks
+	collector.Stop()
+
+	mu.Lock()
+	if emitted != 2 {
+		t.Errorf("emitted %d messages on stop, want 2", emitted)
+	}
+	mu.Unlock()
+
+	if collector.Add(newAlbumMessage(3, "other")) || collector.Pending() != 0 {
+		t.Error("stopped collector buffered a new message")
+	}
+}
+
+func TestMediaGroupCollectorNilCallback(t *testing.T) {
+
+	collector := NewMediaGroupCollector(time.Millisecond, 0, nil)
+	collector.Add(newAlbumMessage(1, "album"))
+	time.Sleep(10 * time.Millisecond)
+	collector.Stop()
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/log"
+)
+
+// Middleware is a type that defines a function that wraps the update handling with additional behavior
+/* A middleware can modify the context before calling next, or return without calling next to stop the handling */
+type Middleware func(next UpdateHandlerFunc) UpdateHandlerFunc
+
+// Use is a method that adds middlewares to the dispatcher
+/* Middlewares run in the order they are added, the first added being the outermost one. */
+/* They wrap the routing, so they run before the handler is selected and the matchers are checked */
+func (dispatcher *Dispatcher) Use(middlewares ...Middleware) {
+	dispatcher.middlewares = append(dispatcher.middlewares, middlewares...)
+}
+
+// RecoveryMiddleware is a function that returns a middleware that recovers from panics in the next handlers
+/* The panic is logged to the error log file and returned as an error so it reaches the error handler */
+/* If the logger is nil the logger of the bot handler is used, if there is one */
+func RecoveryMiddleware(logger log.ILogger, logs *log.LogContainer) Middleware {
+	return func(next UpdateHandlerFunc) UpdateHandlerFunc {
+		return func(ctx *UpdateContext) (err error) {
+
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					err = fmt.Errorf("panic while handling update: %v", recovered)
+
+					/* ---------------------------- Logging ---------------------------- */
+					stmt := fmt.Sprintf("Error: For handling update, recovered from panic { Update ID : %d, "+
+						"Update Type : %s }, %v\n%s", ctx.Update.UpdateID, ctx.Update.Type(), recovered, debug.Stack())
+					if logger != nil {
+						logging(logger, logs, stmt, log.ErrorLogFile)
+					} else if ctx.Bot != nil {
+						ctx.Bot.Logging(stmt, log.ErrorLogFile)
+					}
+				}
+			}()
+
+			return next(ctx)
+		}
+	}
+}
+
+// LoggingMiddleware is a function that returns a middleware that logs every handled update with its duration
+/* If the logger is nil the logger of the bot handler is used, if there is one */
+func LoggingMiddleware(logger log.ILogger, logs *log.LogContainer) Middleware {
+	return func(next UpdateHandlerFunc) UpdateHandlerFunc {
+		return func(ctx *UpdateContext) error {
+
+			var chatID int64
+			var userID int64
+
+			if chat := ctx.Chat(); chat != nil {
+				chatID = chat.ID
+			}
+
+			if user := ctx.Sender(); user != nil {
+				userID = user.ID
+			}
+
+			logStmt := func(stmt, logFile string) {
+				if logger != nil {
+					logging(logger, logs, stmt, logFile)
+				} else if ctx.Bot != nil {
+					ctx.Bot.Logging(stmt, logFile)
+				}
+			}
+
+			/* ---------------------------- Logging ---------------------------- */
+			logStmt(fmt.Sprintf("Started handling update { Update ID : %d, Update Type : %s, Chat ID : %d, "+
+				"User ID : %d }", ctx.Update.UpdateID, ctx.Update.Type(), chatID, userID), log.BotLogFile)
+
+			start := time.Now()
+			err := next(ctx)
+			duration := time.Since(start)
+
+			if err != nil {
+				/* ---------------------------- Logging ---------------------------- */
+				logStmt(fmt.Sprintf("Error: For handling update { Update ID : %d, Update Type : %s, Chat ID : %d, "+
+					"User ID : %d, Duration : %s }, %s", ctx.Update.UpdateID, ctx.Update.Type(), chatID, userID,
+					duration, err.Error()), log.ErrorLogFile)
+
+				return err
+			}
+
+			/* ---------------------------- Logging ---------------------------- */
+			logStmt(fmt.Sprintf("Finished handling update { Update ID : %d, Update Type : %s, Command : %s, "+
+				"Duration : %s }", ctx.Update.UpdateID, ctx.Update.Type(), ctx.Command, duration), log.BotLogFile)
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// recordingMiddleware is a function that returns a middleware that records when it is entered and left
+func recordingMiddleware(name string, calls *[]string) Middleware {
+	return func(next UpdateHandlerFunc) UpdateHandlerFunc {
+		return func(ctx *UpdateContext) error {
+			*calls = append(*calls, "before "+name)
+			err := next(ctx)
+			*calls = append(*calls, "after "+name)
+			return err
+		}
+	}
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+
+	bot := NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil)
+	dispatcher := NewDispatcher(bot)
+
+	var calls []string
+	dispatcher.Use(recordingMiddleware("first", &calls), recordingMiddleware("second", &calls))
+	dispatcher.Use(recordingMiddleware("third", &calls))
+	dispatcher.HandleCommand("start", func(ctx *UpdateContext) error {
+		calls = append(calls, "handler")
+		return nil
+	})
+
+	err := dispatcher.DispatchContext(context.Background(), newTextUpdate("/start", entity.ChatTypePrivate))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "before first, before second, before third, handler, after third, after second, after first"
+	if got := strings.Join(calls, ", "); got != want {
+		t.Errorf("calls are %q, want %q", got, want)
+	}
+}
+
+func TestMiddlewareStopsHandling(t *testing.T) {
+
+	bot := NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil)
+	dispatcher := NewDispatcher(bot)
+
+	// Middlewares run before the routing, so a middleware can set values used by the matchers and the handlers
+	dispatcher.Use(func(next UpdateHandlerFunc) UpdateHandlerFunc {
+		return func(ctx *UpdateContext) error {
+			if ctx.Chat().Type != entity.ChatTypePrivate {
+				return nil
+			}
+
+			ctx.Set("user", "allowed")
+			return next(ctx)
+		}
+	})
+
+	var handled []interface{}
+	dispatcher.SetFallback(func(ctx *UpdateContext) error {
+		value, _ := ctx.Get("user")
+		handled = append(handled, value)
+		return nil
+	})
+
+	dispatcher.Dispatch(newTextUpdate("hello", entity.ChatTypeGroup))
+	dispatcher.Dispatch(newTextUpdate("hello", entity.ChatTypePrivate))
+
+	if len(handled) != 1 || handled[0] != "allowed" {
+		t.Errorf("handled values are %v, want [allowed]", handled)
+	}
+}
+
+func TestRecoveryMiddleware(t *testing.T) {
+
+	bot := NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil)
+	dispatcher := NewDispatcher(bot)
+
+	var calls []string
+	dispatcher.Use(recordingMiddleware("outer", &calls), RecoveryMiddleware(nil, nil))
+	dispatcher.HandleCommand("panic", func(ctx *UpdateContext) error { panic("failed") })
+
+	err := dispatcher.DispatchContext(context.Background(), newTextUpdate("/panic", entity.ChatTypePrivate))
+	if err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("returned error is %v, want the recovered panic", err)
+	}
+
+	// The panic is recovered inside the outer middleware, so it is still left normally
+	if got := strings.Join(calls, ", "); got != "before outer, after outer" {
+		t.Errorf("calls are %q, want %q", got, "before outer, after outer")
+	}
+}
+
+func TestMiddlewareWithoutBot(t *testing.T) {
+
+	// Without a logger or a bot handler the middlewares don't log anything
+	dispatcher := NewDispatcher(nil)
+	dispatcher.Use(LoggingMiddleware(nil, nil), RecoveryMiddleware(nil, nil))
+	dispatcher.HandleCommand("panic", func(ctx *UpdateContext) error { panic("failed") })
+	dispatcher.SetFallback(func(ctx *UpdateContext) error { return nil })
+
+	ctx := context.Background()
+	if err := dispatcher.DispatchContext(ctx, newTextUpdate("hello", entity.ChatTypePrivate)); err != nil {
+		t.Fatal(err)
+	}
+
+	err := dispatcher.DispatchContext(ctx, newTextUpdate("/panic", entity.ChatTypePrivate))
+	if err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("returned error is %v, want the recovered panic", err)
+	}
+}
@@ -0,0 +1,610 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+	"github.com/Benyam-S/go-tg-bot/log"
+)
+
+// ErrInvoiceMismatch is returned when a pre-checkout query doesn't match the invoice it should be paying
+var ErrInvoiceMismatch = errors.New("pre-checkout query doesn't match the invoice")
+
+// defaultPreCheckoutErrorMessage is the message shown to the user when a pre-checkout query doesn't match its invoice
+const defaultPreCheckoutErrorMessage = "The price of the order has changed, please try again with a new invoice."
+
+// SendInvoiceToTelegramChat sends an invoice to the Telegram chat identified by its chat ID
+/* The prices are in the smallest units of the currency, and the payload is an internal identifier of the invoice */
+/* that isn't displayed to the user. The provider token isn't logged */
+/* Available Optional Values */
+/* MaxTipAmount                int64 */
+/* SuggestedTipAmounts         []int64 */
+/* StartParameter              string */
+/* ProviderData                string */
+/* PhotoURL                    string */
+/* PhotoSize                   int64 */
+/* PhotoWidth                  int64 */
+/* PhotoHeight                 int64 */
+/* NeedName                    bool */
+/* NeedPhoneNumber             bool */
+/* NeedEmail                   bool */
+/* NeedShippingAddress         bool */
+/* SendPhoneNumberToProvider   bool */
+/* SendEmailToProvider         bool */
+/* IsFlexible                  bool */
+/* DisableNotification         bool */
+/* ProtectContent              bool */
+/* ReplyToMessageID            int64 */
+/* AllowSendingWithoutReply    bool */
+/* ReplyMarkup                 string */
+func (handler *TelegramBotHandler) SendInvoiceToTelegramChat(chatID interface{}, title string, description string,
+	payload string, providerToken string, currency string, prices []*entity.LabeledPrice,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+	return handler.SendInvoiceToTelegramChatCtx(context.Background(), chatID, title, description, payload,
+		providerToken, currency, prices, optionals)
+}
+
+// SendInvoiceToTelegramChatCtx is the context aware version of SendInvoiceToTelegramChat
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SendInvoiceToTelegramChatCtx(ctx context.Context, chatID interface{}, title string,
+	description string, payload string, providerToken string, currency string, prices []*entity.LabeledPrice,
+	optionals *entity.Optional) (*entity.MessageResponse, error) {
+
+	suggestedTipAmounts := ""
+	startParameter := ""
+	providerData := ""
+	photoURL := ""
+	replyMarkup := ""
+	chatIDS := ""
+
+	var maxTipAmount int64
+	var photoSize int64
+	var photoWidth int64
+	var photoHeight int64
+	var needName bool
+	var needPhoneNumber bool
+	var needEmail bool
+	var needShippingAddress bool
+	var sendPhoneNumberToProvider bool
+	var sendEmailToProvider bool
+	var isFlexible bool
+	var disableNotification bool
+	var protectContent bool
+	var replyToMessageID int64
+	var allowSendingWithoutReply bool
+
+	if id, ok := chatID.(int64); ok {
+		chatIDS = strconv.FormatInt(id, 10)
+	} else if id, ok := chatID.(string); ok {
+		chatIDS = id
+	} else {
+		return nil, errors.New("chat id can only be type string or integer")
+	}
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if len(optionals.SuggestedTipAmounts) > 0 {
+			suggestedTipAmountsByte, _ := json.Marshal(optionals.SuggestedTipAmounts)
+			suggestedTipAmounts = string(suggestedTipAmountsByte)
+		}
+
+		maxTipAmount = optionals.MaxTipAmount
+		startParameter = optionals.StartParameter
+		providerData = optionals.ProviderData
+		photoURL = optionals.PhotoURL
+		photoSize = optionals.PhotoSize
+		photoWidth = optionals.PhotoWidth
+		photoHeight = optionals.PhotoHeight
+		needName = optionals.NeedName
+		needPhoneNumber = optionals.NeedPhoneNumber
+		needEmail = optionals.NeedEmail
+		needShippingAddress = optionals.NeedShippingAddress
+		sendPhoneNumberToProvider = optionals.SendPhoneNumberToProvider
+		sendEmailToProvider = optionals.SendEmailToProvider
+		isFlexible = optionals.IsFlexible
+		disableNotification = optionals.DisableNotification
+		protectContent = optionals.ProtectContent
+		replyToMessageID = optionals.ReplyToMessageID
+		allowSendingWithoutReply = optionals.AllowSendingWithoutReply
+		replyMarkup = optionals.ReplyMarkup
+	}
+
+	pricesByte, _ := json.Marshal(prices)
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started sending invoice to telegram chat { Chat ID : %s, Title : %s, "+
+		"Description : %s, Payload : %s, Currency : %s, Prices : %s, Max Tip Amount : %d, Suggested Tip Amounts : %s, "+
+		"Start Parameter : %s, Provider Data : %s, Photo URL : %s, Photo Size : %d, Photo Width : %d, "+
+		"Photo Height : %d, Need Name : %v, Need Phone Number : %v, Need Email : %v, Need Shipping Address : %v, "+
+		"Send Phone Number To Provider : %v, Send Email To Provider : %v, Is Flexible : %v, "+
+		"Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, Allow Sending Without Reply : %v, "+
+		"Reply Markup : %s }",
+		chatIDS, title, description, payload, currency, pricesByte, maxTipAmount, suggestedTipAmounts, startParameter,
+		providerData, photoURL, photoSize, photoWidth, photoHeight, needName, needPhoneNumber, needEmail,
+		needShippingAddress, sendPhoneNumberToProvider, sendEmailToProvider, isFlexible, disableNotification,
+		protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/sendInvoice"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"chat_id":                       {chatIDS},
+			"title":                         {title},
+			"description":                   {description},
+			"payload":                       {payload},
+			"provider_token":                {providerToken},
+			"currency":                      {currency},
+			"prices":                        {string(pricesByte)},
+			"max_tip_amount":                {strconv.FormatInt(maxTipAmount, 10)},
+			"suggested_tip_amounts":         {suggestedTipAmounts},
+			"start_parameter":               {startParameter},
+			"provider_data":                 {providerData},
+			"photo_url":                     {photoURL},
+			"photo_size":                    {strconv.FormatInt(photoSize, 10)},
+			"photo_width":                   {strconv.FormatInt(photoWidth, 10)},
+			"photo_height":                  {strconv.FormatInt(photoHeight, 10)},
+			"need_name":                     {strconv.FormatBool(needName)},
+			"need_phone_number":             {strconv.FormatBool(needPhoneNumber)},
+			"need_email":                    {strconv.FormatBool(needEmail)},
+			"need_shipping_address":         {strconv.FormatBool(needShippingAddress)},
+			"send_phone_number_to_provider": {strconv.FormatBool(sendPhoneNumberToProvider)},
+			"send_email_to_provider":        {strconv.FormatBool(sendEmailToProvider)},
+			"is_flexible":                   {strconv.FormatBool(isFlexible)},
+			"disable_notification":          {strconv.FormatBool(disableNotification)},
+			"protect_content":               {strconv.FormatBool(protectContent)},
+			"reply_to_message_id":           {strconv.FormatInt(replyToMessageID, 10)},
+			"allow_sending_without_reply":   {strconv.FormatBool(allowSendingWithoutReply)},
+			"reply_markup":                  {replyMarkup},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending invoice to telegram chat { Chat ID : %s, Title : %s, "+
+			"Description : %s, Payload : %s, Currency : %s, Prices : %s, Max Tip Amount : %d, "+
+			"Suggested Tip Amounts : %s, Start Parameter : %s, Provider Data : %s, Photo URL : %s, Photo Size : %d, "+
+			"Photo Width : %d, Photo Height : %d, Need Name : %v, Need Phone Number : %v, Need Email : %v, "+
+			"Need Shipping Address : %v, Send Phone Number To Provider : %v, Send Email To Provider : %v, "+
+			"Is Flexible : %v, Disable Notification : %v, Protect Content : %v, Reply To Message ID : %d, "+
+			"Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, title, description, payload, currency, pricesByte, maxTipAmount, suggestedTipAmounts,
+			startParameter, providerData, photoURL, photoSize, photoWidth, photoHeight, needName, needPhoneNumber,
+			needEmail, needShippingAddress, sendPhoneNumberToProvider, sendEmailToProvider, isFlexible,
+			disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()),
+			log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.MessageResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending invoice to telegram chat, unable to parse response "+
+			"{ Chat ID : %s, Title : %s, Description : %s, Payload : %s, Currency : %s, Prices : %s, "+
+			"Max Tip Amount : %d, Suggested Tip Amounts : %s, Start Parameter : %s, Provider Data : %s, "+
+			"Photo URL : %s, Photo Size : %d, Photo Width : %d, Photo Height : %d, Need Name : %v, "+
+			"Need Phone Number : %v, Need Email : %v, Need Shipping Address : %v, Send Phone Number To Provider : %v, "+
+			"Send Email To Provider : %v, Is Flexible : %v, Disable Notification : %v, Protect Content : %v, "+
+			"Reply To Message ID : %d, Allow Sending Without Reply : %v, Reply Markup : %s }, %s",
+			chatIDS, title, description, payload, currency, pricesByte, maxTipAmount, suggestedTipAmounts,
+			startParameter, providerData, photoURL, photoSize, photoWidth, photoHeight, needName, needPhoneNumber,
+			needEmail, needShippingAddress, sendPhoneNumberToProvider, sendEmailToProvider, isFlexible,
+			disableNotification, protectContent, replyToMessageID, allowSendingWithoutReply, replyMarkup, err.Error()),
+			log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For sending invoice to telegram chat, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished sending invoice to telegram chat, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// CreateInvoiceLink creates a link for an invoice that can be shared with users
+/* The provider token isn't logged. On success, the created invoice link is returned */
+/* Available Optional Values */
+/* MaxTipAmount                int64 */
+/* SuggestedTipAmounts         []int64 */
+/* ProviderData                string */
+/* PhotoURL                    string */
+/* PhotoSize                   int64 */
+/* PhotoWidth                  int64 */
+/* PhotoHeight                 int64 */
+/* NeedName                    bool */
+/* NeedPhoneNumber             bool */
+/* NeedEmail                   bool */
+/* NeedShippingAddress         bool */
+/* SendPhoneNumberToProvider   bool */
+/* SendEmailToProvider         bool */
+/* IsFlexible                  bool */
+func (handler *TelegramBotHandler) CreateInvoiceLink(title string, description string, payload string,
+	providerToken string, currency string, prices []*entity.LabeledPrice,
+	optionals *entity.Optional) (*entity.StringResponse, error) {
+	return handler.CreateInvoiceLinkCtx(context.Background(), title, description, payload, providerToken, currency,
+		prices, optionals)
+}
+
+// CreateInvoiceLinkCtx is the context aware version of CreateInvoiceLink
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) CreateInvoiceLinkCtx(ctx context.Context, title string, description string,
+	payload string, providerToken string, currency string, prices []*entity.LabeledPrice,
+	optionals *entity.Optional) (*entity.StringResponse, error) {
+
+	suggestedTipAmounts := ""
+	providerData := ""
+	photoURL := ""
+
+	var maxTipAmount int64
+	var photoSize int64
+	var photoWidth int64
+	var photoHeight int64
+	var needName bool
+	var needPhoneNumber bool
+	var needEmail bool
+	var needShippingAddress bool
+	var sendPhoneNumberToProvider bool
+	var sendEmailToProvider bool
+	var isFlexible bool
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if len(optionals.SuggestedTipAmounts) > 0 {
+			suggestedTipAmountsByte, _ := json.Marshal(optionals.SuggestedTipAmounts)
+			suggestedTipAmounts = string(suggestedTipAmountsByte)
+		}
+
+		maxTipAmount = optionals.MaxTipAmount
+		providerData = optionals.ProviderData
+		photoURL = optionals.PhotoURL
+		photoSize = optionals.PhotoSize
+		photoWidth = optionals.PhotoWidth
+		photoHeight = optionals.PhotoHeight
+		needName = optionals.NeedName
+		needPhoneNumber = optionals.NeedPhoneNumber
+		needEmail = optionals.NeedEmail
+		needShippingAddress = optionals.NeedShippingAddress
+		sendPhoneNumberToProvider = optionals.SendPhoneNumberToProvider
+		sendEmailToProvider = optionals.SendEmailToProvider
+		isFlexible = optionals.IsFlexible
+	}
+
+	pricesByte, _ := json.Marshal(prices)
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started creating invoice link { Title : %s, Description : %s, Payload : %s, "+
+		"Currency : %s, Prices : %s, Max Tip Amount : %d, Suggested Tip Amounts : %s, Provider Data : %s, "+
+		"Photo URL : %s, Photo Size : %d, Photo Width : %d, Photo Height : %d, Need Name : %v, "+
+		"Need Phone Number : %v, Need Email : %v, Need Shipping Address : %v, Send Phone Number To Provider : %v, "+
+		"Send Email To Provider : %v, Is Flexible : %v }",
+		title, description, payload, currency, pricesByte, maxTipAmount, suggestedTipAmounts, providerData, photoURL,
+		photoSize, photoWidth, photoHeight, needName, needPhoneNumber, needEmail, needShippingAddress,
+		sendPhoneNumberToProvider, sendEmailToProvider, isFlexible), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/createInvoiceLink"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"title":                         {title},
+			"description":                   {description},
+			"payload":                       {payload},
+			"provider_token":                {providerToken},
+			"currency":                      {currency},
+			"prices":                        {string(pricesByte)},
+			"max_tip_amount":                {strconv.FormatInt(maxTipAmount, 10)},
+			"suggested_tip_amounts":         {suggestedTipAmounts},
+			"provider_data":                 {providerData},
+			"photo_url":                     {photoURL},
+			"photo_size":                    {strconv.FormatInt(photoSize, 10)},
+			"photo_width":                   {strconv.FormatInt(photoWidth, 10)},
+			"photo_height":                  {strconv.FormatInt(photoHeight, 10)},
+			"need_name":                     {strconv.FormatBool(needName)},
+			"need_phone_number":             {strconv.FormatBool(needPhoneNumber)},
+			"need_email":                    {strconv.FormatBool(needEmail)},
+			"need_shipping_address":         {strconv.FormatBool(needShippingAddress)},
+			"send_phone_number_to_provider": {strconv.FormatBool(sendPhoneNumberToProvider)},
+			"send_email_to_provider":        {strconv.FormatBool(sendEmailToProvider)},
+			"is_flexible":                   {strconv.FormatBool(isFlexible)},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For creating invoice link { Title : %s, Description : %s, Payload : %s, "+
+			"Currency : %s, Prices : %s, Max Tip Amount : %d, Suggested Tip Amounts : %s, Provider Data : %s, "+
+			"Photo URL : %s, Photo Size : %d, Photo Width : %d, Photo Height : %d, Need Name : %v, "+
+			"Need Phone Number : %v, Need Email : %v, Need Shipping Address : %v, Send Phone Number To Provider : %v, "+
+			"Send Email To Provider : %v, Is Flexible : %v }, %s",
+			title, description, payload, currency, pricesByte, maxTipAmount, suggestedTipAmounts, providerData,
+			photoURL, photoSize, photoWidth, photoHeight, needName, needPhoneNumber, needEmail, needShippingAddress,
+			sendPhoneNumberToProvider, sendEmailToProvider, isFlexible, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.StringResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For creating invoice link, unable to parse response { Title : %s, "+
+			"Description : %s, Payload : %s, Currency : %s, Prices : %s, Max Tip Amount : %d, "+
+			"Suggested Tip Amounts : %s, Provider Data : %s, Photo URL : %s, Photo Size : %d, Photo Width : %d, "+
+			"Photo Height : %d, Need Name : %v, Need Phone Number : %v, Need Email : %v, Need Shipping Address : %v, "+
+			"Send Phone Number To Provider : %v, Send Email To Provider : %v, Is Flexible : %v }, %s",
+			title, description, payload, currency, pricesByte, maxTipAmount, suggestedTipAmounts, providerData,
+			photoURL, photoSize, photoWidth, photoHeight, needName, needPhoneNumber, needEmail, needShippingAddress,
+			sendPhoneNumberToProvider, sendEmailToProvider, isFlexible, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For creating invoice link, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished creating invoice link, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// AnswerToTelegramShippingQuery replies to the shipping query identified by the query id
+/* If ok is true the shipping options should be provided, otherwise the error message explaining why the order */
+/* can't be shipped is required */
+/* Available Optional Values */
+/* ErrorMessage             string */
+func (handler *TelegramBotHandler) AnswerToTelegramShippingQuery(queryID string, ok bool,
+	shippingOptions []*entity.ShippingOption, optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.AnswerToTelegramShippingQueryCtx(context.Background(), queryID, ok, shippingOptions, optionals)
+}
+
+// AnswerToTelegramShippingQueryCtx is the context aware version of AnswerToTelegramShippingQuery
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) AnswerToTelegramShippingQueryCtx(ctx context.Context, queryID string, ok bool,
+	shippingOptions []*entity.ShippingOption, optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+
+	errorMessage := ""
+	shippingOptionsS := ""
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		errorMessage = optionals.ErrorMessage
+	}
+
+	if ok && len(shippingOptions) == 0 {
+		return nil, errors.New("shipping options are required for answering a shipping query with ok")
+	} else if !ok && errorMessage == "" {
+		return nil, errors.New("error message is required for answering a shipping query without ok")
+	}
+
+	if ok {
+		shippingOptionsByte, _ := json.Marshal(shippingOptions)
+		shippingOptionsS = string(shippingOptionsByte)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started answering to telegram shipping query { Shipping Query ID : %s, Ok : %v, "+
+		"Shipping Options : %s, Error Message : %s }", queryID, ok, shippingOptionsS, errorMessage), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/answerShippingQuery"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"shipping_query_id": {queryID},
+			"ok":                {strconv.FormatBool(ok)},
+			"shipping_options":  {shippingOptionsS},
+			"error_message":     {errorMessage},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram shipping query { Shipping Query ID : %s, "+
+			"Ok : %v, Shipping Options : %s, Error Message : %s }, %s", queryID, ok, shippingOptionsS, errorMessage,
+			err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.ChatDefaultResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram shipping query, unable to parse response "+
+			"{ Shipping Query ID : %s, Ok : %v, Shipping Options : %s, Error Message : %s }, %s", queryID, ok,
+			shippingOptionsS, errorMessage, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram shipping query, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished answering to telegram shipping query, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// AnswerToTelegramPreCheckoutQuery replies to the pre-checkout query identified by the query id
+/* The query must be answered within 10 seconds. If ok is false the error message explaining why the checkout */
+/* can't be completed is required, use ConfirmTelegramPreCheckoutQuery for verifying the query before confirming it */
+/* Available Optional Values */
+/* ErrorMessage             string */
+func (handler *TelegramBotHandler) AnswerToTelegramPreCheckoutQuery(queryID string, ok bool,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.AnswerToTelegramPreCheckoutQueryCtx(context.Background(), queryID, ok, optionals)
+}
+
+// AnswerToTelegramPreCheckoutQueryCtx is the context aware version of AnswerToTelegramPreCheckoutQuery
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) AnswerToTelegramPreCheckoutQueryCtx(ctx context.Context, queryID string, ok bool,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+
+	errorMessage := ""
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		errorMessage = optionals.ErrorMessage
+	}
+
+	if !ok && errorMessage == "" {
+		return nil, errors.New("error message is required for answering a pre-checkout query without ok")
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started answering to telegram pre-checkout query { Pre-Checkout Query ID : %s, "+
+		"Ok : %v, Error Message : %s }", queryID, ok, errorMessage), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/answerPreCheckoutQuery"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"pre_checkout_query_id": {queryID},
+			"ok":                    {strconv.FormatBool(ok)},
+			"error_message":         {errorMessage},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram pre-checkout query { Pre-Checkout Query ID : %s, "+
+			"Ok : %v, Error Message : %s }, %s", queryID, ok, errorMessage, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.ChatDefaultResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram pre-checkout query, unable to parse response "+
+			"{ Pre-Checkout Query ID : %s, Ok : %v, Error Message : %s }, %s", queryID, ok, errorMessage,
+			err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For answering to telegram pre-checkout query, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished answering to telegram pre-checkout query, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// ConfirmTelegramPreCheckoutQuery verifies the pre-checkout query against the invoice and answers it
+/* The query is confirmed only if it matches the currency and the total amount of the invoice, otherwise it is */
+/* declined and an error wrapping ErrInvoiceMismatch is returned along with the bot response */
+/* Available Optional Values */
+/* ErrorMessage             string -- shown to the user when the query is declined */
+func (handler *TelegramBotHandler) ConfirmTelegramPreCheckoutQuery(query *entity.PreCheckoutQuery, currency string,
+	totalAmount int64, maxTipAmount int64, optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.ConfirmTelegramPreCheckoutQueryCtx(context.Background(), query, currency, totalAmount, maxTipAmount,
+		optionals)
+}
+
+// ConfirmTelegramPreCheckoutQueryCtx is the context aware version of ConfirmTelegramPreCheckoutQuery
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) ConfirmTelegramPreCheckoutQueryCtx(ctx context.Context,
+	query *entity.PreCheckoutQuery, currency string, totalAmount int64, maxTipAmount int64,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+
+	mismatch := VerifyPreCheckoutQuery(query, currency, totalAmount, maxTipAmount)
+	if mismatch == nil {
+		return handler.AnswerToTelegramPreCheckoutQueryCtx(ctx, query.ID, true, nil)
+	}
+
+	// A nil query can't be answered, so only the mismatch is returned
+	if query == nil {
+		return nil, mismatch
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Error: For confirming telegram pre-checkout query { Pre-Checkout Query ID : %s, "+
+		"Invoice Payload : %s }, %s", query.ID, query.InvoicePayload, mismatch.Error()), log.ErrorLogFile)
+
+	errorMessage := defaultPreCheckoutErrorMessage
+	if optionals != nil && optionals.ErrorMessage != "" {
+		errorMessage = optionals.ErrorMessage
+	}
+
+	botResponse, err := handler.AnswerToTelegramPreCheckoutQueryCtx(ctx, query.ID, false,
+		&entity.Optional{ErrorMessage: errorMessage})
+	if err != nil {
+		return botResponse, err
+	}
+
+	return botResponse, mismatch
+}
+
+// VerifyPreCheckoutQuery is a function that checks if the pre-checkout query matches the currency and the total
+// amount of the invoice
+/* The total amount is in the smallest units of the currency and should include the price of the chosen shipping */
+/* option. If the invoice accepts tips, the query can exceed the total amount by at most the max tip amount */
+func VerifyPreCheckoutQuery(query *entity.PreCheckoutQuery, currency string, totalAmount int64,
+	maxTipAmount int64) error {
+
+	if query == nil {
+		return fmt.Errorf("%w, pre-checkout query is missing", ErrInvoiceMismatch)
+	}
+
+	if !strings.EqualFold(query.Currency, currency) {
+		return fmt.Errorf("%w, expected currency %s but got %s", ErrInvoiceMismatch, currency, query.Currency)
+	}
+
+	if query.TotalAmount < totalAmount || query.TotalAmount > totalAmount+maxTipAmount {
+		return fmt.Errorf("%w, expected total amount %d with a tip of at most %d but got %d", ErrInvoiceMismatch,
+			totalAmount, maxTipAmount, query.TotalAmount)
+	}
+
+	return nil
+}
+
+// InvoiceTotal is a function that returns the sum of the prices, in the smallest units of the currency
+/* It can be used for computing the total amount of an invoice and of the shipping option chosen for it */
+func InvoiceTotal(prices ...*entity.LabeledPrice) int64 {
+
+	var total int64
+	for _, price := range prices {
+		if price != nil {
+			total += price.Amount
+		}
+	}
+
+	return total
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+func TestVerifyPreCheckoutQuery(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		query       *entity.PreCheckoutQuery
+		currency    string
+		total       int64
+		maxTip      int64
+		wantMatched bool
+	}{
+		{"exact total", &entity.PreCheckoutQuery{Currency: "USD", TotalAmount: 1000}, "USD", 1000, 0, true},
+		{"currency case", &entity.PreCheckoutQuery{Currency: "usd", TotalAmount: 1000}, "USD", 1000, 0, true},
+		{"currency mismatch", &entity.PreCheckoutQuery{Currency: "EUR", TotalAmount: 1000}, "USD", 1000, 0, false},
+		{"total below invoice", &entity.PreCheckoutQuery{Currency: "USD", TotalAmount: 999}, "USD", 1000, 500, false},
+		{"total within tip", &entity.PreCheckoutQuery{Currency: "USD", TotalAmount: 1200}, "USD", 1000, 500, true},
+		{"total at max tip", &entity.PreCheckoutQuery{Currency: "USD", TotalAmount: 1500}, "USD", 1000, 500, true},
+		{"total above max tip", &entity.PreCheckoutQuery{Currency: "USD", TotalAmount: 1501}, "USD", 1000, 500,
+			false},
+		{"tip without tips", &entity.PreCheckoutQuery{Currency: "USD", TotalAmount: 1001}, "USD", 1000, 0, false},
+		{"nil query", nil, "USD", 1000, 0, false},
+	}
+
+	for _, test := range tests {
+		err := VerifyPreCheckoutQuery(test.query, test.currency, test.total, test.maxTip)
+		if test.wantMatched && err != nil {
+			t.Errorf("%s: returned %v, want a match", test.name, err)
+		} else if !test.wantMatched && !errors.Is(err, ErrInvoiceMismatch) {
+			t.Errorf("%s: returned %v, want %v", test.name, err, ErrInvoiceMismatch)
+		}
+	}
+}
+
+// newPreCheckoutAPI is a function that returns a fake api handler that records the answered pre-checkout queries
+func newPreCheckoutAPI(answers *[]url.Values) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		*answers = append(*answers, r.PostForm)
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}
+}
+
+func TestConfirmPreCheckoutQuery(t *testing.T) {
+
+	var answers []url.Values
+	bot := newTestBot(t, newPreCheckoutAPI(&answers))
+	query := &entity.PreCheckoutQuery{ID: "q1", Currency: "USD", TotalAmount: 1000, InvoicePayload: "order-1"}
+
+	if _, err := bot.ConfirmTelegramPreCheckoutQuery(query, "USD", 1000, 0, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(answers) != 1 || answers[0].Get("pre_checkout_query_id") != "q1" || answers[0].Get("ok") != "true" {
+		t.Errorf("answers are %v, want the query to be confirmed", answers)
+	}
+}
+
+func TestConfirmPreCheckoutQueryMismatch(t *testing.T) {
+
+	var answers []url.Values
+	bot := newTestBot(t, newPreCheckoutAPI(&answers))
+	query := &entity.PreCheckoutQuery{ID: "q1", Currency: "USD", TotalAmount: 900, InvoicePayload: "order-1"}
+
+	botResponse, err := bot.ConfirmTelegramPreCheckoutQuery(query, "USD", 1000, 0, nil)
+	if !errors.Is(err, ErrInvoiceMismatch) {
+		t.Errorf("returned error is %v, want %v", err, ErrInvoiceMismatch)
+	}
+
+	if botResponse == nil || !botResponse.Ok {
+		t.Errorf("bot response is %v, want the response of the declining answer", botResponse)
+	}
+
+	if len(answers) != 1 || answers[0].Get("ok") != "false" ||
+		answers[0].Get("error_message") != defaultPreCheckoutErrorMessage {
+		t.Errorf("answers are %v, want the query to be declined with the default error message", answers)
+	}
+
+	_, err = bot.ConfirmTelegramPreCheckoutQuery(query, "EUR", 900, 0,
+		&entity.Optional{ErrorMessage: "Only euros are accepted."})
+	if !errors.Is(err, ErrInvoiceMismatch) {
+		t.Errorf("returned error is %v, want %v", err, ErrInvoiceMismatch)
+	}
+
+	if len(answers) != 2 || answers[1].Get("ok") != "false" ||
+		answers[1].Get("error_message") != "Only euros are accepted." {
+		t.Errorf("answers are %v, want the query to be declined with the given error message", answers)
+	}
+}
+
+func TestConfirmPreCheckoutQueryNil(t *testing.T) {
+
+	var answers []url.Values
+	bot := newTestBot(t, newPreCheckoutAPI(&answers))
+
+	// A nil query can't be answered, so only the mismatch is returned
+	botResponse, err := bot.ConfirmTelegramPreCheckoutQuery(nil, "USD", 1000, 0, nil)
+	if !errors.Is(err, ErrInvoiceMismatch) || botResponse != nil {
+		t.Errorf("returned %v and %v, want only %v", botResponse, err, ErrInvoiceMismatch)
+	}
+
+	if len(answers) != 0 {
+		t.Errorf("answers are %v, want no answer", answers)
+	}
+}
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+	"github.com/Benyam-S/go-tg-bot/log"
+)
+
+// requestBuilder is a type that defines a function that builds a request from the request values
+/* The request is built again for every attempt, since a request body can only be read once */
+type requestBuilder func(ctx context.Context, values url.Values) (*http.Request, error)
+
+// apiResponseStatus is a type that holds the status part of a telegram api response
+type apiResponseStatus struct {
+	Ok          bool                      `json:"ok"`
+	ErrorCode   int64                     `json:"error_code"`
+	Description string                    `json:"description"`
+	Parameters  entity.ResponseParameters `json:"parameters"`
+}
+
+// postForm is a method that sends the form values to the telegram api using a POST request bound to the context
+func (handler *TelegramBotHandler) postForm(ctx context.Context, telegramAPI string,
+	values url.Values) (*http.Response, error) {
+
+	return handler.send(ctx, values, func(ctx context.Context, values url.Values) (*http.Request, error) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, telegramAPI,
+			strings.NewReader(values.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		return request, nil
+	}, true)
+}
+
+// get is a method that sends a GET request bound to the context to the telegram api
+func (handler *TelegramBotHandler) get(ctx context.Context, telegramAPI string) (*http.Response, error) {
+
+	apiURL, err := url.Parse(telegramAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	original := apiURL.Query()
+	return handler.send(ctx, original, func(ctx context.Context, values url.Values) (*http.Request, error) {
+
+		// The query is only rebuilt if the values have been changed by a retry
+		if values.Get("chat_id") != original.Get("chat_id") {
+			apiURL.RawQuery = values.Encode()
+		}
+
+		return http.NewRequestWithContext(ctx, http.MethodGet, apiURL.String(), nil)
+	}, true)
+}
+
+// send is a method that sends the request built from the values, retrying it based on the retry policy
+/* Requests that can't be built more than once, like the ones uploading a reader, are never retried */
+func (handler *TelegramBotHandler) send(ctx context.Context, values url.Values, build requestBuilder,
+	replayable bool) (*http.Response, error) {
+
+	policy := handler.retryPolicy
+	if !replayable {
+		policy = nil
+	}
+
+	for attempt := 1; ; attempt++ {
+
+		request, err := build(ctx, values)
+		if err != nil {
+			return nil, err
+		}
+
+		if handler.rateLimiter != nil && limitedMethod(apiMethod(request)) {
+			if err = handler.rateLimiter.Wait(ctx, values.Get("chat_id")); err != nil {
+				if request.Body != nil {
+					request.Body.Close()
+				}
+				return nil, err
+			}
+		}
+
+		response, err := handler.HTTPClient().Do(request)
+
+		// Returning the result as it is, if retrying is disabled or there are no attempts left
+		if policy == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+			return response, err
+		}
+
+		if err != nil {
+			if !policy.RetryServerErrors {
+				return nil, err
+			}
+
+			if err = handler.wait(ctx, policy.backoff(attempt), request, err.Error()); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// Only unsuccessful responses are inspected, successful responses are returned unread
+		if response.StatusCode < http.StatusBadRequest {
+			return response, nil
+		}
+
+		body, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		status := new(apiResponseStatus)
+		if json.Unmarshal(body, status) != nil {
+			status.ErrorCode = int64(response.StatusCode)
+		}
+
+		switch {
+		case status.Parameters.MigrateToChatID != 0 && policy.FollowMigration && values.Get("chat_id") != "":
+			fromChatID := values.Get("chat_id")
+			toChatID := strconv.FormatInt(status.Parameters.MigrateToChatID, 10)
+
+			/* ---------------------------- Logging ---------------------------- */
+			handler.Logging(fmt.Sprintf("Chat has been migrated, retrying request { Method : %s, "+
+				"From Chat ID : %s, To Chat ID : %s }", apiMethod(request), fromChatID, toChatID), log.BotLogFile)
+
+			values = copyValues(values)
+			values.Set("chat_id", toChatID)
+
+			if policy.OnChatMigrated != nil {
+				policy.OnChatMigrated(fromChatID, status.Parameters.MigrateToChatID)
+			}
+
+		case status.ErrorCode == http.StatusTooManyRequests:
+			delay := time.Duration(status.Parameters.RetryAfter) * time.Second
+			if delay <= 0 {
+				delay = policy.backoff(attempt)
+			}
+
+			if err = handler.wait(ctx, delay, request, status.Description); err != nil {
+				return nil, err
+			}
+
+		case response.StatusCode >= http.StatusInternalServerError && policy.RetryServerErrors:
+			if err = handler.wait(ctx, policy.backoff(attempt), request, status.Description); err != nil {
+				return nil, err
+			}
+
+		default:
+			return response, nil
+		}
+	}
+}
+
+// wait is a method that blocks until the delay passes before retrying the request or the context is done
+func (handler *TelegramBotHandler) wait(ctx context.Context, delay time.Duration, request *http.Request,
+	reason string) error {
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Error: For sending request, retrying in %s { Method : %s }, %s",
+		delay, apiMethod(request), reason), log.ErrorLogFile)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// apiMethod is a function that returns the telegram api method name of the request, used for logging
+/* The url path is not logged as it is, since it contains the bot access token */
+func apiMethod(request *http.Request) string {
+	path := request.URL.Path
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// copyValues is a function that returns a copy of the url values
+func copyValues(values url.Values) url.Values {
+
+	copied := make(url.Values, len(values))
+	for key, value := range values {
+		copied[key] = append([]string(nil), value...)
+	}
+
+	return copied
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy is a type that defines how the requests that failed temporarily are retried
+/* Flood limited requests are retried after the 'retry_after' period given by telegram and requests targeting */
+/* a group that was migrated to a supergroup are sent again to the supergroup */
+type RetryPolicy struct {
+	MaxAttempts       int           // The maximum number of attempts including the first one
+	BaseDelay         time.Duration // The delay before the first retry, it doubles for every retry
+	MaxDelay          time.Duration // The maximum delay between retries, zero means no limit
+	Jitter            float64       // The fraction of the delay that is randomized, between 0 and 1
+	RetryServerErrors bool          // Retries network errors and 5xx responses, which may duplicate sent messages
+	FollowMigration   bool          // Re-targets the request to the chat given by 'migrate_to_chat_id'
+
+	// OnChatMigrated is called when a request is re-targeted, so the stored chat ids can be updated
+	OnChatMigrated func(fromChatID string, toChatID int64)
+}
+
+// DefaultRetryPolicy is a function that returns a retry policy with the recommended values
+/* Only flood limited and migrated requests are retried, RetryServerErrors has to be set explicitly since */
+/* retrying a request that may have reached telegram can send the same message twice */
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 30 * time.Second, Jitter: 0.2,
+		FollowMigration: true}
+}
+
+// SetRetryPolicy is a method that sets the retry policy used for all the requests, nil disables retrying
+/* It should be set before the handler is used */
+func (handler *TelegramBotHandler) SetRetryPolicy(policy *RetryPolicy) {
+	handler.retryPolicy = policy
+}
+
+// backoff is a method that returns the delay before the given retry using exponential backoff with jitter
+func (policy *RetryPolicy) backoff(retry int) time.Duration {
+
+	delay := policy.BaseDelay
+	for i := 1; i < retry; i++ {
+		delay *= 2
+		if policy.MaxDelay > 0 && delay >= policy.MaxDelay {
+			delay = policy.MaxDelay
+			break
+		}
+	}
+
+	if policy.Jitter > 0 && delay > 0 {
+		jitter := policy.Jitter
+		if jitter > 1 {
+			jitter = 1
+		}
+
+		// Randomizing the delay within [delay - jitter * delay, delay + jitter * delay]
+		delta := float64(delay) * jitter
+		delay = time.Duration(float64(delay) - delta + rand.Float64()*2*delta)
+	}
+
+	return delay
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRetryAPI is a type that replies to the requests with the queued responses, recording the requested chat ids
+type fakeRetryAPI struct {
+	mu        sync.Mutex
+	responses []string // Each response is prefixed by its status code, e.g. "429 {...}"
+	chatIDs   []string
+	times     []time.Time
+}
+
+// ServeHTTP is a method that replies with the next queued response, or with a sent message if none is left
+func (api *fakeRetryAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.chatIDs = append(api.chatIDs, r.FormValue("chat_id"))
+	api.times = append(api.times, time.Now())
+
+	if len(api.responses) == 0 {
+		w.Write([]byte(`{"ok":true,"result":{"message_id":9}}`))
+		return
+	}
+
+	response := api.responses[0]
+	api.responses = api.responses[1:]
+
+	var code int
+	switch response[:3] {
+	case "429":
+		code = http.StatusTooManyRequests
+	case "400":
+		code = http.StatusBadRequest
+	default:
+		code = http.StatusBadGateway
+	}
+
+	w.WriteHeader(code)
+	w.Write([]byte(response[4:]))
+}
+
+// newRetryTestPolicy is a function that returns the default retry policy with short delays
+func newRetryTestPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.Jitter = 0
+
+	return policy
+}
+
+func TestRetryTooManyRequests(t *testing.T) {
+
+	api := &fakeRetryAPI{responses: []string{`429 {"ok":false,"error_code":429,` +
+		`"description":"Too Many Requests: retry after 1","parameters":{"retry_after":1}}`}}
+	bot := newTestBot(t, api.ServeHTTP)
+	bot.SetRetryPolicy(newRetryTestPolicy())
+
+	botResponse, err := bot.SendReplyToTelegramChat(int64(5), "hello", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if botResponse.Result.MessageID != 9 {
+		t.Errorf("message id is %d, want 9", botResponse.Result.MessageID)
+	}
+
+	if len(api.times) != 2 {
+		t.Fatalf("sent %d requests, want 2", len(api.times))
+	}
+
+	// The retry has to wait for the period given by telegram instead of the backoff delay
+	if delay := api.times[1].Sub(api.times[0]); delay < time.Second {
+		t.Errorf("retried after %s, want at least the 1s retry_after period", delay)
+	}
+}
+
+func TestRetryMigratedChat(t *testing.T) {
+
+	api := &fakeRetryAPI{responses: []string{`400 {"ok":false,"error_code":400,` +
+		`"description":"Bad Request: group chat was upgraded to a supergroup chat",` +
+		`"parameters":{"migrate_to_chat_id":-1005}}`}}
+	bot := newTestBot(t, api.ServeHTTP)
+
+	var migratedFrom string
+	var migratedTo int64
+	policy := newRetryTestPolicy()
+	policy.OnChatMigrated = func(fromChatID string, toChatID int64) {
+		migratedFrom, migratedTo = fromChatID, toChatID
+	}
+	bot.SetRetryPolicy(policy)
+
+	if _, err := bot.SendReplyToTelegramChat(int64(-5), "hello", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(api.chatIDs) != 2 || api.chatIDs[0] != "-5" || api.chatIDs[1] != "-1005" {
+		t.Errorf("requested chat ids are %v, want [-5 -1005]", api.chatIDs)
+	}
+
+	if migratedFrom != "-5" || migratedTo != -1005 {
+		t.Errorf("migration callback received %s => %d, want -5 => -1005", migratedFrom, migratedTo)
+	}
+}
+
+func TestRetryServerErrors(t *testing.T) {
+
+	serverError := `502 {"ok":false,"error_code":502,"description":"Bad Gateway"}`
+
+	// Server errors aren't retried by the default policy, since the message may have been already sent
+	api := &fakeRetryAPI{responses: []string{serverError}}
+	bot := newTestBot(t, api.ServeHTTP)
+	bot.SetRetryPolicy(newRetryTestPolicy())
+
+	if _, err := bot.SendReplyToTelegramChat(int64(5), "hello", nil); err == nil {
+		t.Error("expected the server error to be returned")
+	}
+
+	if len(api.chatIDs) != 1 {
+		t.Errorf("sent %d requests, want 1", len(api.chatIDs))
+	}
+
+	api = &fakeRetryAPI{responses: []string{serverError, serverError}}
+	bot = newTestBot(t, api.ServeHTTP)
+	policy := newRetryTestPolicy()
+	policy.RetryServerErrors = true
+	bot.SetRetryPolicy(policy)
+
+	if _, err := bot.SendReplyToTelegramChat(int64(5), "hello", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(api.chatIDs) != 3 {
+		t.Errorf("sent %d requests, want 3", len(api.chatIDs))
+	}
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+
+	tooManyRequests := `429 {"ok":false,"error_code":429,"description":"Too Many Requests"}`
+	api := &fakeRetryAPI{responses: []string{tooManyRequests, tooManyRequests, tooManyRequests}}
+	bot := newTestBot(t, api.ServeHTTP)
+
+	policy := newRetryTestPolicy()
+	policy.MaxAttempts = 2
+	bot.SetRetryPolicy(policy)
+
+	_, err := bot.SendReplyToTelegramChat(int64(5), "hello", nil)
+	if !errors.Is(err, ErrTooManyRequests) {
+		t.Errorf("returned error is %v, want the too many requests error", err)
+	}
+
+	if len(api.chatIDs) != 2 {
+		t.Errorf("sent %d requests, want 2", len(api.chatIDs))
+	}
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/session"
+)
+
+// chatSessionKey and userSessionKey are the context keys used for storing the sessions of an update
+const chatSessionKey = "session:chat"
+const userSessionKey = "session:user"
+
+// SessionMiddleware is a function that returns a middleware that attaches the chat and user sessions to the context
+/* The sessions are keyed using the chat id and the user id of the update and can be accessed using */
+/* the ChatSession and UserSession methods of the context. The ttl is applied every time a value is set */
+func SessionMiddleware(store session.ISessionStore, ttl time.Duration) Middleware {
+	return func(next UpdateHandlerFunc) UpdateHandlerFunc {
+		return func(ctx *UpdateContext) error {
+
+			if chat := ctx.Chat(); chat != nil {
+				ctx.Set(chatSessionKey, session.NewSession(store, "chat:"+strconv.FormatInt(chat.ID, 10), ttl))
+			}
+
+			if user := ctx.Sender(); user != nil {
+				ctx.Set(userSessionKey, session.NewSession(store, "user:"+strconv.FormatInt(user.ID, 10), ttl))
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// ChatSession is a method that returns the session of the update's chat
+/* Nil is returned if the update has no chat or the session middleware isn't used */
+func (ctx *UpdateContext) ChatSession() *session.Session {
+	value, _ := ctx.Get(chatSessionKey)
+	chatSession, _ := value.(*session.Session)
+	return chatSession
+}
+
+// UserSession is a method that returns the session of the user that caused the update
+/* Nil is returned if the update has no user or the session middleware isn't used */
+func (ctx *UpdateContext) UserSession() *session.Session {
+	value, _ := ctx.Get(userSessionKey)
+	userSession, _ := value.(*session.Session)
+	return userSession
+}
+
+// SessionConversationStore is a type that keeps the conversation states in a session store
+/* Using a file session store allows the conversations to survive restarts */
+type SessionConversationStore struct {
+	store session.ISessionStore
+	ttl   time.Duration
+}
+
+// NewSessionConversationStore is a function that returns a conversation store backed by the session store
+/* The ttl is applied every time a state is stored, zero means the states never expire */
+func NewSessionConversationStore(store session.ISessionStore, ttl time.Duration) *SessionConversationStore {
+	return &SessionConversationStore{store: store, ttl: ttl}
+}
+
+// GetState is a method that returns the conversation state stored using the key
+func (store *SessionConversationStore) GetState(key string) (*ConversationState, error) {
+
+	state := new(ConversationState)
+	err := store.store.Get("conversation:"+key, state)
+	if errors.Is(err, session.ErrSessionNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// SetState is a method that stores the conversation state using the key
+func (store *SessionConversationStore) SetState(key string, state *ConversationState) error {
+	return store.store.Set("conversation:"+key, state, store.ttl)
+}
+
+// DeleteState is a method that removes the conversation state stored using the key
+func (store *SessionConversationStore) DeleteState(key string) error {
+	return store.store.Delete("conversation:" + key)
+}
@@ -0,0 +1,305 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+	"github.com/Benyam-S/go-tg-bot/log"
+)
+
+// GetUpdates receives incoming updates using long polling
+/* Available Optional Values */
+/* Offset                   int64 */
+/* Limit                    int64 */
+/* Timeout                  int64 -- in seconds, 0 means short polling */
+/* AllowedUpdates           []string */
+func (handler *TelegramBotHandler) GetUpdates(optionals *entity.Optional) (*entity.UpdatesResponse, error) {
+	return handler.GetUpdatesCtx(context.Background(), optionals)
+}
+
+// GetUpdatesCtx is the context aware version of GetUpdates
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) GetUpdatesCtx(ctx context.Context,
+	optionals *entity.Optional) (*entity.UpdatesResponse, error) {
+
+	allowedUpdates := ""
+
+	var offset int64
+	var limit int64
+	var timeout int64
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if optionals.AllowedUpdates != nil {
+			allowedUpdatesByte, _ := json.Marshal(optionals.AllowedUpdates)
+			allowedUpdates = string(allowedUpdatesByte)
+		}
+
+		offset = optionals.Offset
+		limit = optionals.Limit
+		timeout = optionals.Timeout
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started getting updates { Offset : %d, Limit : %d, Timeout : %d, "+
+		"Allowed Updates : %s }", offset, limit, timeout, allowedUpdates), log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/getUpdates"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"offset":          {strconv.FormatInt(offset, 10)},
+			"limit":           {strconv.FormatInt(limit, 10)},
+			"timeout":         {strconv.FormatInt(timeout, 10)},
+			"allowed_updates": {allowedUpdates},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting updates { Offset : %d, Limit : %d, Timeout : %d, "+
+			"Allowed Updates : %s }, %s", offset, limit, timeout, allowedUpdates, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.UpdatesResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting updates, unable to parse response { Offset : %d, Limit : %d, "+
+			"Timeout : %d, Allowed Updates : %s }, %s", offset, limit, timeout, allowedUpdates, err.Error()),
+			log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting updates, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished getting updates, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// UpdatePoller is a type that continuously receives updates from telegram using getUpdates long polling
+type UpdatePoller struct {
+	Timeout        int64         // Long polling timeout in seconds
+	Limit          int64         // Limits the number of updates to be retrieved per request, 1-100
+	AllowedUpdates []string      // List of the update types the bot should receive, nil means all except chat_member
+	RetryDelay     time.Duration // The time to wait before polling again after a failed request
+
+	handler    *TelegramBotHandler
+	offset     int64
+	mu         sync.Mutex
+	cancel     context.CancelFunc
+	done       chan struct{}
+	delivering bool // Whether the callback is running, in which case Stop can't wait for the poller
+}
+
+// NewUpdatePoller is a method that returns a new update poller for the bot handler
+func (handler *TelegramBotHandler) NewUpdatePoller(timeout int64, allowedUpdates ...string) *UpdatePoller {
+	return &UpdatePoller{Timeout: timeout, Limit: 100, AllowedUpdates: allowedUpdates,
+		RetryDelay: 3 * time.Second, handler: handler}
+}
+
+// Offset is a method that returns the identifier of the next update the poller will request
+func (poller *UpdatePoller) Offset() int64 {
+	poller.mu.Lock()
+	defer poller.mu.Unlock()
+
+	return poller.offset
+}
+
+// SetOffset is a method that sets the identifier of the first update to be requested, used for resuming polling
+func (poller *UpdatePoller) SetOffset(offset int64) {
+	poller.mu.Lock()
+	defer poller.mu.Unlock()
+
+	poller.offset = offset
+}
+
+// Start is a method that starts polling in the background and passes each update to the callback in order
+/* The callback is called from a single goroutine, so the next update is requested only after it returns */
+func (poller *UpdatePoller) Start(callback func(update *entity.Update)) error {
+
+	if callback == nil {
+		return errors.New("callback is required")
+	}
+
+	poller.mu.Lock()
+	defer poller.mu.Unlock()
+
+	if poller.cancel != nil {
+		return errors.New("update poller is already running")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	poller.cancel = cancel
+	poller.done = make(chan struct{})
+
+	go poller.poll(ctx, func(update *entity.Update) bool {
+		poller.setDelivering(true)
+		defer poller.setDelivering(false)
+
+		callback(update)
+		return true
+	}, poller.done)
+
+	return nil
+}
+
+// StartChannel is a method that starts polling in the background and delivers the updates on the returned channel
+/* The channel is closed once the poller is stopped */
+func (poller *UpdatePoller) StartChannel(bufferSize int) (<-chan *entity.Update, error) {
+
+	updates := make(chan *entity.Update, bufferSize)
+
+	poller.mu.Lock()
+	defer poller.mu.Unlock()
+
+	if poller.cancel != nil {
+		return nil, errors.New("update poller is already running")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	poller.cancel = cancel
+	poller.done = make(chan struct{})
+
+	go func() {
+		defer close(updates)
+		poller.poll(ctx, func(update *entity.Update) bool {
+			select {
+			case updates <- update:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}, poller.done)
+	}()
+
+	return updates, nil
+}
+
+// Stop is a method that stops the poller, cancelling any pending request, and waits for it to finish
+/* The offset of the already handled updates is confirmed to telegram so they won't be received again. */
+/* While the callback is running, like when the poller is stopped from within the callback, Stop only */
+/* cancels the poller without waiting, and the poller finishes once the callback returns */
+func (poller *UpdatePoller) Stop() {
+
+	poller.mu.Lock()
+	cancel, done, delivering := poller.cancel, poller.done, poller.delivering
+	poller.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+
+	// The callback is run by the polling goroutine, so waiting for it from within the callback would never return
+	if delivering {
+		return
+	}
+
+	<-done
+}
+
+// setDelivering is a method that marks whether the callback is running
+func (poller *UpdatePoller) setDelivering(delivering bool) {
+	poller.mu.Lock()
+	defer poller.mu.Unlock()
+
+	poller.delivering = delivering
+}
+
+// finish is a method that resets the stopped poller and confirms the offset of the already handled updates
+func (poller *UpdatePoller) finish(done chan struct{}) {
+
+	poller.mu.Lock()
+	if poller.done == done {
+		poller.cancel = nil
+		poller.done = nil
+	}
+	offset := poller.offset
+	poller.mu.Unlock()
+
+	// Confirming the handled updates, since telegram only confirms them when a request with a higher offset is made
+	if offset != 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		poller.handler.GetUpdatesCtx(ctx, &entity.Optional{Offset: offset, Limit: 1,
+			AllowedUpdates: poller.AllowedUpdates})
+	}
+}
+
+// poll is a method that runs the polling loop until the context is cancelled
+/* deliver reports whether the update has been handed over, undelivered updates are requested again */
+func (poller *UpdatePoller) poll(ctx context.Context, deliver func(update *entity.Update) bool, done chan struct{}) {
+
+	defer close(done)
+	defer poller.finish(done)
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		botResponse, err := poller.handler.GetUpdatesCtx(ctx, &entity.Optional{Offset: poller.Offset(),
+			Limit: poller.Limit, Timeout: poller.Timeout, AllowedUpdates: poller.AllowedUpdates})
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			// Waiting for the period requested by telegram if the bot is flood limited
+			delay := poller.RetryDelay
+			apiError := new(APIError)
+			if errors.As(err, &apiError) && apiError.RetryAfter() > delay {
+				delay = apiError.RetryAfter()
+			}
+
+			/* ---------------------------- Logging ---------------------------- */
+			poller.handler.Logging(fmt.Sprintf("Error: For polling updates, retrying in %s, %s",
+				delay, err.Error()), log.ErrorLogFile)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+
+			continue
+		}
+
+		for i := range botResponse.Result {
+			if ctx.Err() != nil {
+				return
+			}
+
+			update := &botResponse.Result[i]
+			if !deliver(update) {
+				return
+			}
+
+			// Advancing the offset only after the update is handled
+			poller.SetOffset(update.UpdateID + 1)
+		}
+	}
+}
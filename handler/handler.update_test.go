@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// newTestBot is a function that returns a bot handler that sends all of its requests to a fake local api server
+func newTestBot(t *testing.T, api http.HandlerFunc) *TelegramBotHandler {
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	return NewTelegramBotHandler(server.URL+"/bot", "TOKEN", 1, "TestBot", nil, nil)
+}
+
+// fakeUpdatesAPI is a type that serves getUpdates requests from a fixed list of updates, recording the offsets
+type fakeUpdatesAPI struct {
+	mu      sync.Mutex
+	updates []int64
+	offsets []int64
+}
+
+// ServeHTTP is a method that returns the updates with an identifier that isn't lower than the requested offset
+func (api *fakeUpdatesAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.URL.Path != "/botTOKEN/getUpdates" {
+		http.NotFound(w, r)
+		return
+	}
+
+	offset, _ := strconv.ParseInt(r.FormValue("offset"), 10, 64)
+
+	api.mu.Lock()
+	api.offsets = append(api.offsets, offset)
+	result := "["
+	for _, updateID := range api.updates {
+		if updateID >= offset {
+			if len(result) > 1 {
+				result += ","
+			}
+			result += fmt.Sprintf(`{"update_id":%d,"message":{"message_id":%d,"text":"hi"}}`, updateID, updateID)
+		}
+	}
+	result += "]"
+	api.mu.Unlock()
+
+	// Holding empty responses for a while, the same way telegram holds long polling requests
+	if result == "[]" {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+
+	fmt.Fprintf(w, `{"ok":true,"result":%s}`, result)
+}
+
+// requestedOffsets is a method that returns a copy of the offsets requested so far
+func (api *fakeUpdatesAPI) requestedOffsets() []int64 {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return append([]int64(nil), api.offsets...)
+}
+
+func TestUpdatePollerOffset(t *testing.T) {
+
+	api := &fakeUpdatesAPI{updates: []int64{10, 11, 12}}
+	bot := newTestBot(t, api.ServeHTTP)
+
+	poller := bot.NewUpdatePoller(1)
+	updates, err := poller.StartChannel(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var received []int64
+	stopped := make(chan struct{})
+	for update := range updates {
+		received = append(received, update.UpdateID)
+		if len(received) == 3 {
+			go func() {
+				poller.Stop()
+				close(stopped)
+			}()
+		}
+	}
+	<-stopped
+
+	if fmt.Sprint(received) != "[10 11 12]" {
+		t.Fatalf("received updates %v, want [10 11 12]", received)
+	}
+
+	if poller.Offset() != 13 {
+		t.Errorf("offset is %d, want 13", poller.Offset())
+	}
+
+	// The first request has no offset, every following request has to confirm the handled updates
+	offsets := api.requestedOffsets()
+	if len(offsets) < 2 || offsets[0] != 0 {
+		t.Fatalf("requested offsets %v, want a first request without offset", offsets)
+	}
+
+	for _, offset := range offsets[1:] {
+		if offset != 13 {
+			t.Fatalf("requested offsets %v, want 13 after the first request", offsets)
+		}
+	}
+}
+
+func TestUpdatePollerResumesFromOffset(t *testing.T) {
+
+	api := &fakeUpdatesAPI{updates: []int64{10, 11, 12}}
+	bot := newTestBot(t, api.ServeHTTP)
+
+	received := make(chan int64, 3)
+	poller := bot.NewUpdatePoller(1)
+	poller.SetOffset(12)
+	if err := poller.Start(func(update *entity.Update) { received <- update.UpdateID }); err != nil {
+		t.Fatal(err)
+	}
+
+	if updateID := <-received; updateID != 12 {
+		t.Errorf("received update %d, want 12", updateID)
+	}
+
+	poller.Stop()
+
+	select {
+	case updateID := <-received:
+		t.Errorf("received update %d after resuming from offset 12", updateID)
+	default:
+	}
+}
+
+func TestUpdatePollerStop(t *testing.T) {
+
+	api := &fakeUpdatesAPI{}
+	bot := newTestBot(t, api.ServeHTTP)
+
+	poller := bot.NewUpdatePoller(1)
+	if err := poller.Start(func(update *entity.Update) {}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := poller.Start(func(update *entity.Update) {}); err == nil {
+		t.Error("starting a running poller should fail")
+	}
+
+	// Stopping has to end the polling loop and wait for it to finish
+	stopped := make(chan struct{})
+	go func() {
+		poller.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("stop didn't return")
+	}
+
+	// Without handled updates no confirming request is sent, so the request count stays the same
+	requests := len(api.requestedOffsets())
+	time.Sleep(50 * time.Millisecond)
+	if len(api.requestedOffsets()) != requests {
+		t.Error("poller kept polling after stop")
+	}
+
+	// A stopped poller can be started again
+	if err := poller.Start(func(update *entity.Update) {}); err != nil {
+		t.Fatal(err)
+	}
+	poller.Stop()
+	poller.Stop()
+}
+
+func TestUpdatePollerStopConfirmsOffset(t *testing.T) {
+
+	api := &fakeUpdatesAPI{updates: []int64{7}}
+	bot := newTestBot(t, api.ServeHTTP)
+
+	poller := bot.NewUpdatePoller(1)
+	if err := poller.Start(func(update *entity.Update) {}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Waiting for the callback to return, since Stop doesn't wait for the poller while the callback is running
+	for deadline := time.Now().Add(2 * time.Second); poller.Offset() != 8; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("update wasn't handled")
+		}
+	}
+	poller.Stop()
+
+	offsets := api.requestedOffsets()
+	if offsets[len(offsets)-1] != 8 {
+		t.Errorf("last requested offset is %d, want 8", offsets[len(offsets)-1])
+	}
+}
+
+func TestUpdatePollerStopFromCallback(t *testing.T) {
+
+	api := &fakeUpdatesAPI{updates: []int64{7, 8}}
+	bot := newTestBot(t, api.ServeHTTP)
+
+	// Stopping from within the callback only cancels the poller, so the callback isn't blocked by it
+	returned := make(chan int64, 2)
+	poller := bot.NewUpdatePoller(1)
+	if err := poller.Start(func(update *entity.Update) {
+		poller.Stop()
+		returned <- update.UpdateID
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case updateID := <-returned:
+		if updateID != 7 {
+			t.Errorf("handled update %d, want 7", updateID)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("stop didn't return within the callback")
+	}
+
+	// The poller finishes once the callback returns, confirming the update that stopped it
+	for deadline := time.Now().Add(2 * time.Second); ; time.Sleep(time.Millisecond) {
+		if err := poller.Start(func(update *entity.Update) {}); err == nil {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("poller didn't finish after the callback returned")
+		}
+	}
+	poller.Stop()
+
+	select {
+	case updateID := <-returned:
+		t.Errorf("handled update %d after the poller was stopped", updateID)
+	default:
+	}
+
+	if offsets := api.requestedOffsets(); offsets[1] != 8 {
+		t.Errorf("requested offsets %v, want the stopping update to be confirmed", offsets)
+	}
+}
@@ -0,0 +1,291 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// uploadFiles is a type that holds the files to be uploaded with a request, identified by their form field name
+type uploadFiles map[string]*entity.InputFile
+
+// add is a method that resolves a file parameter given as a string or an input file into its form value
+/* Files that have to be uploaded are stored under the field name, and an empty value is returned for them */
+/* unless attach is true, where the 'attach://<field>' reference is returned instead */
+func (files uploadFiles) add(field string, file interface{}, attach bool) (string, error) {
+
+	switch value := file.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case *entity.InputFile:
+		if value == nil {
+			return "", nil
+		} else if !value.NeedsUpload() {
+			return value.Value(), nil
+		}
+
+		files[field] = value
+		if attach {
+			return "attach://" + field, nil
+		}
+		return "", nil
+	}
+
+	return "", fmt.Errorf("%s can only be type string or *InputFile", field)
+}
+
+// attach is a method that resolves a media file into its value, files to be uploaded are referenced by attach://
+func (files uploadFiles) attach(file interface{}) (string, error) {
+	return files.add(fmt.Sprintf("file%d", len(files)), file, true)
+}
+
+// attachMedia is a method that returns a copy of the input media having all its files resolved into strings
+/* The files that have to be uploaded are added to the upload files and referenced using attach://, and a missing */
+/* media type is set from the type of the input media */
+func (files uploadFiles) attachMedia(media interface{}) (interface{}, error) {
+
+	if isNilInputMedia(media) {
+		return nil, errors.New("input media can't be nil")
+	}
+
+	var err error
+
+	switch value := media.(type) {
+	case entity.InputMediaPhoto:
+		return files.attachMedia(&value)
+	case entity.InputMediaVideo:
+		return files.attachMedia(&value)
+	case entity.InputMediaAnimation:
+		return files.attachMedia(&value)
+	case entity.InputMediaAudio:
+		return files.attachMedia(&value)
+	case entity.InputMediaDocument:
+		return files.attachMedia(&value)
+
+	case *entity.InputMediaPhoto:
+		copied := *value
+		if copied.Type == "" {
+			copied.Type = "photo"
+		}
+		if copied.Media, err = files.attach(copied.Media); err != nil {
+			return nil, err
+		}
+		return copied, nil
+
+	case *entity.InputMediaVideo:
+		copied := *value
+		if copied.Type == "" {
+			copied.Type = "video"
+		}
+		if copied.Media, err = files.attach(copied.Media); err != nil {
+			return nil, err
+		}
+		if copied.Thumb, err = files.attach(copied.Thumb); err != nil {
+			return nil, err
+		}
+		return copied, nil
+
+	case *entity.InputMediaAnimation:
+		copied := *value
+		if copied.Type == "" {
+			copied.Type = "animation"
+		}
+		if copied.Media, err = files.attach(copied.Media); err != nil {
+			return nil, err
+		}
+		if copied.Thumb, err = files.attach(copied.Thumb); err != nil {
+			return nil, err
+		}
+		return copied, nil
+
+	case *entity.InputMediaAudio:
+		copied := *value
+		if copied.Type == "" {
+			copied.Type = "audio"
+		}
+		if copied.Media, err = files.attach(copied.Media); err != nil {
+			return nil, err
+		}
+		if copied.Thumb, err = files.attach(copied.Thumb); err != nil {
+			return nil, err
+		}
+		return copied, nil
+
+	case *entity.InputMediaDocument:
+		copied := *value
+		if copied.Type == "" {
+			copied.Type = "document"
+		}
+		if copied.Media, err = files.attach(copied.Media); err != nil {
+			return nil, err
+		}
+		if copied.Thumb, err = files.attach(copied.Thumb); err != nil {
+			return nil, err
+		}
+		return copied, nil
+	}
+
+	// Other values are sent as they are
+	return media, nil
+}
+
+// validateMediaGroup is a function that checks if the input media can be sent together as an album
+/* An album has 2 to 10 items, documents and audio files can only be grouped with items of the same type */
+/* while photos and videos can be mixed */
+func validateMediaGroup(media []interface{}) error {
+
+	if len(media) < 2 || len(media) > 10 {
+		return errors.New("media group must include 2 to 10 items")
+	}
+
+	group := ""
+	for _, item := range media {
+
+		if isNilInputMedia(item) {
+			return errors.New("media group can't include nil items")
+		}
+
+		var itemGroup string
+		switch item.(type) {
+		case entity.InputMediaPhoto, *entity.InputMediaPhoto, entity.InputMediaVideo, *entity.InputMediaVideo:
+			itemGroup = "photo and video"
+		case entity.InputMediaDocument, *entity.InputMediaDocument:
+			itemGroup = "document"
+		case entity.InputMediaAudio, *entity.InputMediaAudio:
+			itemGroup = "audio"
+		default:
+			return fmt.Errorf("media group can only include photos, videos, documents or audio files, not %T", item)
+		}
+
+		if group == "" {
+			group = itemGroup
+		} else if group != itemGroup {
+			return fmt.Errorf("media group can't mix %s items with %s items", group, itemGroup)
+		}
+	}
+
+	return nil
+}
+
+// isNilInputMedia is a function that checks if the input media is nil or a nil pointer to an input media type
+func isNilInputMedia(media interface{}) bool {
+
+	switch value := media.(type) {
+	case nil:
+		return true
+	case *entity.InputMediaPhoto:
+		return value == nil
+	case *entity.InputMediaVideo:
+		return value == nil
+	case *entity.InputMediaAnimation:
+		return value == nil
+	case *entity.InputMediaAudio:
+		return value == nil
+	case *entity.InputMediaDocument:
+		return value == nil
+	}
+
+	return false
+}
+
+// replayable is a method that checks if all the files can be uploaded more than once
+func (files uploadFiles) replayable() bool {
+
+	for _, file := range files {
+		if !file.Replayable() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// postMultipart is a method that sends the values and the files to the telegram api using a multipart/form-data
+// POST request bound to the context
+/* The body is streamed, so the files are never loaded into memory. If there are no files to be uploaded */
+/* the values are sent as a normal form. Requests uploading a reader are not retried since it can be read only once */
+func (handler *TelegramBotHandler) postMultipart(ctx context.Context, telegramAPI string, values url.Values,
+	files uploadFiles) (*http.Response, error) {
+
+	if len(files) == 0 {
+		return handler.postForm(ctx, telegramAPI, values)
+	}
+
+	return handler.send(ctx, values, func(ctx context.Context, values url.Values) (*http.Request, error) {
+
+		reader, writer := io.Pipe()
+		form := multipart.NewWriter(writer)
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, telegramAPI, reader)
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("Content-Type", form.FormDataContentType())
+
+		// The body is written while the request is being sent, the pipe is closed when the request body is closed
+		go func() {
+			writer.CloseWithError(writeMultipart(form, values, files))
+		}()
+
+		return request, nil
+	}, files.replayable())
+}
+
+// writeMultipart is a function that writes the values and the content of the files to the multipart writer
+func writeMultipart(form *multipart.Writer, values url.Values, files uploadFiles) error {
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range values[key] {
+			if err := form.WriteField(key, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	fields := make([]string, 0, len(files))
+	for field := range files {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		if err := writeMultipartFile(form, field, files[field]); err != nil {
+			return err
+		}
+	}
+
+	return form.Close()
+}
+
+// writeMultipartFile is a function that writes the content of the file to the multipart writer
+func writeMultipartFile(form *multipart.Writer, field string, file *entity.InputFile) error {
+
+	content, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	part, err := form.CreateFormFile(field, file.Name())
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(part, content)
+	return err
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// multipartRequest is a type that holds the parts of a multipart request received by the fake api server
+type multipartRequest struct {
+	contentType string
+	values      url.Values
+	files       map[string]string // The file name and the content of each file part, separated by ':'
+}
+
+// newMultipartAPI is a function that returns a fake api handler that records the received multipart requests
+/* Every request is answered with the given result */
+func newMultipartAPI(t *testing.T, requests *[]multipartRequest, result string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		received := multipartRequest{contentType: r.Header.Get("Content-Type"), files: map[string]string{}}
+		if strings.HasPrefix(received.contentType, "multipart/form-data") {
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("unable to parse multipart body: %v", err)
+			} else {
+				received.values = url.Values(r.MultipartForm.Value)
+				for field, headers := range r.MultipartForm.File {
+					file, _ := headers[0].Open()
+					content, _ := ioutil.ReadAll(file)
+					file.Close()
+					received.files[field] = headers[0].Filename + ":" + string(content)
+				}
+			}
+		} else {
+			r.ParseForm()
+			received.values = r.PostForm
+		}
+
+		*requests = append(*requests, received)
+		w.Write([]byte(`{"ok":true,"result":` + result + `}`))
+	}
+}
+
+func TestPostMultipart(t *testing.T) {
+
+	var requests []multipartRequest
+	bot := newTestBot(t, newMultipartAPI(t, &requests, `{"message_id":9}`))
+
+	files := uploadFiles{"document": entity.NewInputFileBytes("a.txt", []byte("DOCUMENT")),
+		"thumb": entity.NewInputFileBytes("t.jpg", []byte("THUMB"))}
+	values := url.Values{"chat_id": {"5"}, "caption": {"hello"}}
+
+	response, err := bot.postMultipart(context.Background(), bot.BotAPIAccessPoint+bot.BotAccessToken+
+		"/sendDocument", values, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if len(requests) != 1 {
+		t.Fatalf("sent %d requests, want 1", len(requests))
+	}
+
+	received := requests[0]
+	if received.values.Get("chat_id") != "5" || received.values.Get("caption") != "hello" {
+		t.Errorf("received values %v, want the chat id and the caption", received.values)
+	}
+
+	if received.files["document"] != "a.txt:DOCUMENT" || received.files["thumb"] != "t.jpg:THUMB" {
+		t.Errorf("received files %v, want the document and the thumb", received.files)
+	}
+}
+
+func TestPostMultipartWithoutFiles(t *testing.T) {
+
+	var requests []multipartRequest
+	bot := newTestBot(t, newMultipartAPI(t, &requests, `{"message_id":9}`))
+
+	// Files that are already stored on the telegram servers are sent as a normal form
+	_, err := bot.SendDocumentToTelegramChat(int64(5), entity.NewInputFileID("FILE_ID"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := requests[0]
+	if received.contentType != "application/x-www-form-urlencoded" {
+		t.Errorf("content type is %q, want a url encoded form", received.contentType)
+	}
+
+	if received.values.Get("document") != "FILE_ID" {
+		t.Errorf("document is %q, want FILE_ID", received.values.Get("document"))
+	}
+}
+
+func TestSendDocumentUpload(t *testing.T) {
+
+	var requests []multipartRequest
+	bot := newTestBot(t, newMultipartAPI(t, &requests, `{"message_id":9}`))
+
+	_, err := bot.SendDocumentToTelegramChat(int64(5),
+		entity.NewInputFileReader("a.txt", strings.NewReader("DOCUMENT")),
+		&entity.Optional{Thumb: entity.NewInputFileBytes("t.jpg", []byte("THUMB"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := requests[0]
+	if received.values.Get("chat_id") != "5" {
+		t.Errorf("chat id is %q, want 5", received.values.Get("chat_id"))
+	}
+
+	if received.files["document"] != "a.txt:DOCUMENT" || received.files["thumb"] != "t.jpg:THUMB" {
+		t.Errorf("received files %v, want the document and the thumb", received.files)
+	}
+}
+
+func TestSendMediaGroupUpload(t *testing.T) {
+
+	var requests []multipartRequest
+	bot := newTestBot(t, newMultipartAPI(t, &requests, `[{"message_id":9},{"message_id":10}]`))
+
+	_, err := bot.SendMediaGroupToTelegramChat(int64(5), []interface{}{
+		entity.InputMediaPhoto{Media: entity.NewInputFileBytes("a.jpg", []byte("A"))},
+		&entity.InputMediaPhoto{Media: "FILE_ID"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := requests[0]
+	if received.files["file0"] != "a.jpg:A" {
+		t.Errorf("received files %v, want the uploaded photo as file0", received.files)
+	}
+
+	media := received.values.Get("media")
+	if !strings.Contains(media, `"media":"attach://file0"`) || !strings.Contains(media, `"media":"FILE_ID"`) {
+		t.Errorf("media is %s, want the uploaded photo attached and the file id kept", media)
+	}
+}
+
+func TestValidateMediaGroup(t *testing.T) {
+
+	var nilPhoto *entity.InputMediaPhoto
+	photo := entity.InputMediaPhoto{Media: "FILE_ID"}
+	video := &entity.InputMediaVideo{Media: "FILE_ID"}
+	document := entity.InputMediaDocument{Media: "FILE_ID"}
+
+	tests := []struct {
+		name  string
+		media []interface{}
+		valid bool
+	}{
+		{"photos and videos", []interface{}{photo, video}, true},
+		{"single item", []interface{}{photo}, false},
+		{"documents mixed with photos", []interface{}{photo, document}, false},
+		{"nil item", []interface{}{photo, nil}, false},
+		{"nil pointer item", []interface{}{photo, nilPhoto}, false},
+	}
+
+	for _, test := range tests {
+		if err := validateMediaGroup(test.media); (err == nil) != test.valid {
+			t.Errorf("%s: validation returned %v, want valid to be %t", test.name, err, test.valid)
+		}
+	}
+}
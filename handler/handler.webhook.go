@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+	"github.com/Benyam-S/go-tg-bot/log"
+)
+
+// WebhookSecretTokenHeader is the header telegram uses for sending the webhook secret token
+const WebhookSecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// maxWebhookBodySize is the maximum size of an update request body accepted by the webhook handler
+const maxWebhookBodySize = 10 << 20
+
+// SetWebhook specifies a url for receiving incoming updates via an outgoing webhook
+/* Uploading a self-signed certificate isn't supported */
+/* Available Optional Values */
+/* IPAddress                string */
+/* MaxConnections           int64 */
+/* AllowedUpdates           []string */
+/* DropPendingUpdates       bool */
+/* SecretToken              string */
+func (handler *TelegramBotHandler) SetWebhook(webhookURL string,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.SetWebhookCtx(context.Background(), webhookURL, optionals)
+}
+
+// SetWebhookCtx is the context aware version of SetWebhook
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) SetWebhookCtx(ctx context.Context, webhookURL string,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+
+	ipAddress := ""
+	allowedUpdates := ""
+	secretToken := ""
+
+	var maxConnections int64
+	var dropPendingUpdates bool
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		if optionals.AllowedUpdates != nil {
+			allowedUpdatesByte, _ := json.Marshal(optionals.AllowedUpdates)
+			allowedUpdates = string(allowedUpdatesByte)
+		}
+
+		ipAddress = optionals.IPAddress
+		maxConnections = optionals.MaxConnections
+		dropPendingUpdates = optionals.DropPendingUpdates
+		secretToken = optionals.SecretToken
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started setting webhook { URL : %s, IP Address : %s, Max Connections : %d, "+
+		"Allowed Updates : %s, Drop Pending Updates : %v }", webhookURL, ipAddress, maxConnections,
+		allowedUpdates, dropPendingUpdates), log.BotLogFile)
+
+	values := url.Values{
+		"url":                  {webhookURL},
+		"ip_address":           {ipAddress},
+		"allowed_updates":      {allowedUpdates},
+		"drop_pending_updates": {strconv.FormatBool(dropPendingUpdates)},
+		"secret_token":         {secretToken},
+	}
+
+	if maxConnections > 0 {
+		values.Set("max_connections", strconv.FormatInt(maxConnections, 10))
+	}
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/setWebhook"
+	response, err := handler.postForm(ctx, telegramAPI, values)
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For setting webhook { URL : %s, IP Address : %s, Max Connections : %d, "+
+			"Allowed Updates : %s, Drop Pending Updates : %v }, %s", webhookURL, ipAddress, maxConnections,
+			allowedUpdates, dropPendingUpdates, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.ChatDefaultResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For setting webhook, unable to parse response { URL : %s, IP Address : %s, "+
+			"Max Connections : %d, Allowed Updates : %s, Drop Pending Updates : %v }, %s", webhookURL, ipAddress,
+			maxConnections, allowedUpdates, dropPendingUpdates, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For setting webhook, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished setting webhook, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// DeleteWebhook removes the webhook integration so updates can be received using getUpdates
+/* Available Optional Values */
+/* DropPendingUpdates       bool */
+func (handler *TelegramBotHandler) DeleteWebhook(optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+	return handler.DeleteWebhookCtx(context.Background(), optionals)
+}
+
+// DeleteWebhookCtx is the context aware version of DeleteWebhook
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) DeleteWebhookCtx(ctx context.Context,
+	optionals *entity.Optional) (*entity.ChatDefaultResponse, error) {
+
+	var dropPendingUpdates bool
+
+	// If optionals aren't nil then set the values
+	if optionals != nil {
+		dropPendingUpdates = optionals.DropPendingUpdates
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Started deleting webhook { Drop Pending Updates : %v }", dropPendingUpdates),
+		log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/deleteWebhook"
+	response, err := handler.postForm(ctx,
+		telegramAPI,
+		url.Values{
+			"drop_pending_updates": {strconv.FormatBool(dropPendingUpdates)},
+		})
+
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For deleting webhook { Drop Pending Updates : %v }, %s",
+			dropPendingUpdates, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.ChatDefaultResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For deleting webhook, unable to parse response "+
+			"{ Drop Pending Updates : %v }, %s", dropPendingUpdates, err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For deleting webhook, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished deleting webhook, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// GetWebhookInfo gets the current webhook status
+func (handler *TelegramBotHandler) GetWebhookInfo() (*entity.WebhookInfoResponse, error) {
+	return handler.GetWebhookInfoCtx(context.Background())
+}
+
+// GetWebhookInfoCtx is the context aware version of GetWebhookInfo
+/* The context is used for cancelling the request and setting its deadline */
+func (handler *TelegramBotHandler) GetWebhookInfoCtx(ctx context.Context) (*entity.WebhookInfoResponse, error) {
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging("Started getting webhook info", log.BotLogFile)
+
+	var telegramAPI string = handler.BotAPIAccessPoint + handler.BotAccessToken + "/getWebhookInfo"
+	response, err := handler.get(ctx, telegramAPI)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting webhook info, %s", err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	botResponse := new(entity.WebhookInfoResponse)
+	err = json.NewDecoder(response.Body).Decode(botResponse)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting webhook info, unable to parse response, %s",
+			err.Error()), log.ErrorLogFile)
+
+		return nil, err
+	}
+
+	if !botResponse.Ok {
+		/* ---------------------------- Logging ---------------------------- */
+		handler.Logging(fmt.Sprintf("Error: For getting webhook info, Bot Response => %s",
+			botResponse.ToString()), log.ErrorLogFile)
+
+		return botResponse, newAPIError(botResponse.ErrorCode, botResponse.Description, botResponse.Parameters)
+	}
+
+	/* ---------------------------- Logging ---------------------------- */
+	handler.Logging(fmt.Sprintf("Finished getting webhook info, Bot Response => %s",
+		botResponse.ToString()), log.BotLogFile)
+
+	return botResponse, nil
+}
+
+// WebhookHandler is a type that receives updates sent by telegram to the webhook url
+/* It validates the secret token, decodes the update and acknowledges telegram before handing the update */
+/* to the callback in a separate goroutine, so updates may be handled concurrently and out of order */
+type WebhookHandler struct {
+	handler     *TelegramBotHandler
+	secretToken string
+	callback    func(update *entity.Update)
+	wg          sync.WaitGroup
+}
+
+// NewWebhookHandler is a method that returns a new webhook handler that passes received updates to the callback
+/* The secret token should be the same as the one used in SetWebhook, empty value disables the validation */
+func (handler *TelegramBotHandler) NewWebhookHandler(secretToken string,
+	callback func(update *entity.Update)) *WebhookHandler {
+	return &WebhookHandler{handler: handler, secretToken: secretToken, callback: callback}
+}
+
+// ServeHTTP is a method that handles a single webhook request sent by telegram
+func (webhook *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if webhook.secretToken != "" {
+		secretToken := r.Header.Get(WebhookSecretTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(secretToken), []byte(webhook.secretToken)) != 1 {
+			/* ---------------------------- Logging ---------------------------- */
+			webhook.handler.Logging(fmt.Sprintf("Error: For receiving webhook update, invalid secret token "+
+				"{ Remote Address : %s }", r.RemoteAddr), log.ErrorLogFile)
+
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	update := new(entity.Update)
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookBodySize)).Decode(update)
+	if err != nil {
+		/* ---------------------------- Logging ---------------------------- */
+		webhook.handler.Logging(fmt.Sprintf("Error: For receiving webhook update, unable to parse request "+
+			"{ Remote Address : %s }, %s", r.RemoteAddr, err.Error()), log.ErrorLogFile)
+
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+	if webhook.callback != nil {
+		webhook.wg.Add(1)
+		go func() {
+			defer webhook.wg.Done()
+			webhook.callback(update)
+		}()
+	}
+}
+
+// Wait is a method that blocks until all the updates handed to the callback have been handled
+/* It should be called after shutting down the server for a graceful shutdown */
+func (webhook *WebhookHandler) Wait() {
+	webhook.wg.Wait()
+}
+
+// NewWebhookServer is a method that returns an http server that serves the webhook handler on the given path
+/* The server should be started using ListenAndServe or ListenAndServeTLS and stopped using Shutdown */
+func (handler *TelegramBotHandler) NewWebhookServer(address, path string, webhook *WebhookHandler) (*http.Server, error) {
+
+	if webhook == nil {
+		return nil, errors.New("webhook handler is required")
+	}
+
+	if path == "" {
+		path = "/"
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, webhook)
+
+	return &http.Server{Addr: address, Handler: mux}, nil
+}
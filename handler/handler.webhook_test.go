@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Benyam-S/go-tg-bot/entity"
+)
+
+// postWebhookUpdate is a function that sends the body to the webhook handler with the given secret token header
+func postWebhookUpdate(webhook *WebhookHandler, secretToken, body string) int {
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	if secretToken != "" {
+		request.Header.Set(WebhookSecretTokenHeader, secretToken)
+	}
+
+	recorder := httptest.NewRecorder()
+	webhook.ServeHTTP(recorder, request)
+
+	return recorder.Code
+}
+
+func TestWebhookHandlerSecretToken(t *testing.T) {
+
+	bot := NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil)
+
+	received := make(chan int64, 1)
+	webhook := bot.NewWebhookHandler("secret", func(update *entity.Update) { received <- update.UpdateID })
+
+	tests := []struct {
+		name        string
+		secretToken string
+		want        int
+	}{
+		{"missing secret token", "", http.StatusUnauthorized},
+		{"invalid secret token", "wrong", http.StatusUnauthorized},
+		{"valid secret token", "secret", http.StatusOK},
+	}
+
+	for _, test := range tests {
+		if code := postWebhookUpdate(webhook, test.secretToken, `{"update_id":5}`); code != test.want {
+			t.Errorf("%s: status code is %d, want %d", test.name, code, test.want)
+		}
+	}
+
+	webhook.Wait()
+
+	if updateID := <-received; updateID != 5 {
+		t.Errorf("received update %d, want 5", updateID)
+	}
+
+	select {
+	case updateID := <-received:
+		t.Errorf("received update %d from a rejected request", updateID)
+	default:
+	}
+}
+
+func TestWebhookHandlerWithoutSecretToken(t *testing.T) {
+
+	bot := NewTelegramBotHandler("", "TOKEN", 1, "TestBot", nil, nil)
+	webhook := bot.NewWebhookHandler("", nil)
+
+	if code := postWebhookUpdate(webhook, "", `{"update_id":5}`); code != http.StatusOK {
+		t.Errorf("status code is %d, want %d", code, http.StatusOK)
+	}
+
+	if code := postWebhookUpdate(webhook, "", `{"update_id":`); code != http.StatusBadRequest {
+		t.Errorf("status code of an invalid update is %d, want %d", code, http.StatusBadRequest)
+	}
+
+	recorder := httptest.NewRecorder()
+	webhook.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/webhook", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status code of a GET request is %d, want %d", recorder.Code, http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,180 @@
+package session
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fileItem is a type that defines the content of a file used for storing a single value
+type fileItem struct {
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
+// FileStore is a type that keeps the session values in files, so they survive restarts
+/* Each value is stored in its own file inside the store directory */
+type FileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileStore is a function that returns a new file session store using the given directory
+/* The directory is created if it doesn't exist */
+func NewFileStore(dir string) (*FileStore, error) {
+
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Get is a method that decodes the value stored with the key into value
+func (store *FileStore) Get(key string, value interface{}) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	item, err := store.read(key)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(item.Value, value)
+}
+
+// Set is a method that stores the value with the key, zero ttl means the value never expires
+func (store *FileStore) Set(key string, value interface{}, ttl time.Duration) error {
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(&fileItem{Key: key, Value: data, ExpiresAt: expiration(ttl)})
+	if err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	// Writing to a temporary file first so a value is never partially written
+	file, err := ioutil.TempFile(store.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	err = os.Rename(file.Name(), store.path(key))
+	if err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	return nil
+}
+
+// Delete is a method that removes the value stored with the key
+func (store *FileStore) Delete(key string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	err := os.Remove(store.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// TTL is a method that returns the remaining time to live of the value stored with the key
+func (store *FileStore) TTL(key string) (time.Duration, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	item, err := store.read(key)
+	if err != nil {
+		return 0, err
+	}
+
+	return remaining(item.ExpiresAt), nil
+}
+
+// Cleanup is a method that removes all the expired values, expired values are otherwise removed when accessed
+func (store *FileStore) Cleanup() error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	files, err := ioutil.ReadDir(store.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+
+		path := filepath.Join(store.dir, file.Name())
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		item := new(fileItem)
+		if json.Unmarshal(content, item) == nil && expired(item.ExpiresAt) {
+			os.Remove(path)
+		}
+	}
+
+	return nil
+}
+
+// read is a method that reads the item stored with the key, expired items are removed
+func (store *FileStore) read(key string) (*fileItem, error) {
+
+	content, err := ioutil.ReadFile(store.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrSessionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	item := new(fileItem)
+	err = json.Unmarshal(content, item)
+	if err != nil {
+		return nil, err
+	}
+
+	if expired(item.ExpiresAt) {
+		os.Remove(store.path(key))
+		return nil, ErrSessionNotFound
+	}
+
+	return item, nil
+}
+
+// path is a method that returns the path of the file used for storing the value of the key
+/* The key is hashed since it may contain characters that aren't allowed in file names */
+func (store *FileStore) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(store.dir, hex.EncodeToString(hash[:])+".json")
+}
@@ -0,0 +1,167 @@
+package session
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// storedFiles is a function that returns the names of the files found in the store directory
+func storedFiles(t *testing.T, dir string) []string {
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		names = append(names, file.Name())
+	}
+
+	return names
+}
+
+func TestFileStore(t *testing.T) {
+
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type profile struct {
+		Name string
+		Age  int
+	}
+
+	var value profile
+	if err = store.Get("user:5", &value); err != ErrSessionNotFound {
+		t.Errorf("getting a missing key returned %v, want %v", err, ErrSessionNotFound)
+	}
+
+	// Keys are hashed, so they can contain characters that aren't allowed in file names
+	if err = store.Set("user:5/profile", profile{Name: "bob", Age: 30}, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = store.Get("user:5/profile", &value); err != nil || value.Name != "bob" || value.Age != 30 {
+		t.Errorf("got %+v with error %v, want the stored profile", value, err)
+	}
+
+	// The value is written to a temporary file that is renamed, so no temporary file is left behind
+	files := storedFiles(t, store.dir)
+	if len(files) != 1 || !strings.HasSuffix(files[0], ".json") {
+		t.Errorf("store directory has %v, want a single json file", files)
+	}
+
+	if err = store.Delete("user:5/profile"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = store.Delete("user:5/profile"); err != nil {
+		t.Errorf("deleting a missing key returned %v", err)
+	}
+
+	if files = storedFiles(t, store.dir); len(files) != 0 {
+		t.Errorf("store directory has %v after deleting, want no files", files)
+	}
+}
+
+func TestFileStorePersistence(t *testing.T) {
+
+	dir := t.TempDir()
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = store.Set("step", "address", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = store.Set("step", "phone", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new store using the same directory, like after a restart, reads the values written by the previous one
+	reloaded, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var step string
+	if err = reloaded.Get("step", &step); err != nil || step != "phone" {
+		t.Errorf("got %q with error %v, want the latest value %q", step, err, "phone")
+	}
+
+	if ttl, err := reloaded.TTL("step"); err != nil || ttl <= 0 || ttl > time.Hour {
+		t.Errorf("ttl is %s with error %v, want the stored expiration to be kept", ttl, err)
+	}
+}
+
+func TestFileStoreTTL(t *testing.T) {
+
+	dir := t.TempDir()
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = store.Set("short", 1, 20*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = store.Set("kept", 2, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+
+	reloaded, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var value int
+	if err = reloaded.Get("short", &value); err != ErrSessionNotFound {
+		t.Errorf("getting an expired key returned %v, want %v", err, ErrSessionNotFound)
+	}
+
+	// The file of the expired value is deleted once it is accessed
+	if _, err = os.Stat(reloaded.path("short")); !os.IsNotExist(err) {
+		t.Errorf("file of the expired value still exists, %v", err)
+	}
+
+	if ttl, err := reloaded.TTL("kept"); err != nil || ttl != 0 {
+		t.Errorf("ttl is %s with error %v, want zero for a value that never expires", ttl, err)
+	}
+}
+
+func TestFileStoreCleanup(t *testing.T) {
+
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store.Set("expired", 1, time.Millisecond)
+	store.Set("kept", 2, 0)
+	time.Sleep(5 * time.Millisecond)
+
+	if err = store.Cleanup(); err != nil {
+		t.Fatal(err)
+	}
+
+	if files := storedFiles(t, store.dir); len(files) != 1 {
+		t.Errorf("store directory has %v after cleanup, want only the value that never expires", files)
+	}
+
+	var value int
+	if err = store.Get("kept", &value); err != nil || value != 2 {
+		t.Errorf("got %d with error %v, want 2", value, err)
+	}
+}
@@ -0,0 +1,90 @@
+package session
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// memoryItem is a type that holds a value stored in the memory store
+type memoryItem struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// MemoryStore is a type that keeps the session values in memory, the values are lost when the program exits
+type MemoryStore struct {
+	mu    sync.Mutex
+	items map[string]memoryItem
+}
+
+// NewMemoryStore is a function that returns a new in memory session store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{items: make(map[string]memoryItem)}
+}
+
+// Get is a method that decodes the value stored with the key into value
+func (store *MemoryStore) Get(key string, value interface{}) error {
+	store.mu.Lock()
+	item, ok := store.items[key]
+	if ok && expired(item.expiresAt) {
+		delete(store.items, key)
+		ok = false
+	}
+	store.mu.Unlock()
+
+	if !ok {
+		return ErrSessionNotFound
+	}
+
+	return json.Unmarshal(item.data, value)
+}
+
+// Set is a method that stores the value with the key, zero ttl means the value never expires
+func (store *MemoryStore) Set(key string, value interface{}, ttl time.Duration) error {
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.items[key] = memoryItem{data: data, expiresAt: expiration(ttl)}
+	return nil
+}
+
+// Delete is a method that removes the value stored with the key
+func (store *MemoryStore) Delete(key string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	delete(store.items, key)
+	return nil
+}
+
+// TTL is a method that returns the remaining time to live of the value stored with the key
+func (store *MemoryStore) TTL(key string) (time.Duration, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	item, ok := store.items[key]
+	if !ok || expired(item.expiresAt) {
+		return 0, ErrSessionNotFound
+	}
+
+	return remaining(item.expiresAt), nil
+}
+
+// Cleanup is a method that removes all the expired values, expired values are otherwise removed when accessed
+func (store *MemoryStore) Cleanup() {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	for key, item := range store.items {
+		if expired(item.expiresAt) {
+			delete(store.items, key)
+		}
+	}
+}
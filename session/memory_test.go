@@ -0,0 +1,130 @@
+package session
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryStore(t *testing.T) {
+
+	store := NewMemoryStore()
+
+	var value string
+	if err := store.Get("missing", &value); err != ErrSessionNotFound {
+		t.Errorf("getting a missing key returned %v, want %v", err, ErrSessionNotFound)
+	}
+
+	if err := store.Set("name", "bob", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Get("name", &value); err != nil || value != "bob" {
+		t.Errorf("got %q with error %v, want %q", value, err, "bob")
+	}
+
+	if ttl, err := store.TTL("name"); err != nil || ttl != 0 {
+		t.Errorf("ttl is %s with error %v, want zero for a value that never expires", ttl, err)
+	}
+
+	if err := store.Delete("name"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Get("name", &value); err != ErrSessionNotFound {
+		t.Errorf("getting a deleted key returned %v, want %v", err, ErrSessionNotFound)
+	}
+}
+
+func TestMemoryStoreTTL(t *testing.T) {
+
+	store := NewMemoryStore()
+
+	if err := store.Set("short", 1, 20*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Set("long", 2, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	if ttl, err := store.TTL("short"); err != nil || ttl <= 0 || ttl > 20*time.Millisecond {
+		t.Errorf("ttl is %s with error %v, want a remaining time within 20ms", ttl, err)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+
+	var value int
+	if err := store.Get("short", &value); err != ErrSessionNotFound {
+		t.Errorf("getting an expired key returned %v, want %v", err, ErrSessionNotFound)
+	}
+
+	if _, err := store.TTL("short"); err != ErrSessionNotFound {
+		t.Errorf("ttl of an expired key returned %v, want %v", err, ErrSessionNotFound)
+	}
+
+	// Expired values are deleted once they are accessed
+	store.mu.Lock()
+	_, ok := store.items["short"]
+	store.mu.Unlock()
+	if ok {
+		t.Error("expired value wasn't deleted")
+	}
+
+	if err := store.Get("long", &value); err != nil || value != 2 {
+		t.Errorf("got %d with error %v, want 2", value, err)
+	}
+}
+
+func TestMemoryStoreCleanup(t *testing.T) {
+
+	store := NewMemoryStore()
+	store.Set("expired", 1, time.Millisecond)
+	store.Set("kept", 2, 0)
+
+	time.Sleep(5 * time.Millisecond)
+	store.Cleanup()
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if _, ok := store.items["expired"]; ok {
+		t.Error("cleanup kept the expired value")
+	}
+
+	if _, ok := store.items["kept"]; !ok {
+		t.Error("cleanup removed a value that never expires")
+	}
+}
+
+func TestSession(t *testing.T) {
+
+	store := NewMemoryStore()
+	chat := NewSession(store, "chat:5", time.Hour)
+	other := NewSession(store, "chat:6", time.Hour)
+
+	if err := chat.Set("step", "name"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Sessions with different prefixes don't share their values
+	var step string
+	if err := other.Get("step", &step); err != ErrSessionNotFound {
+		t.Errorf("getting the value of another session returned %v, want %v", err, ErrSessionNotFound)
+	}
+
+	if err := chat.Get("step", &step); err != nil || step != "name" {
+		t.Errorf("got %q with error %v, want %q", step, err, "name")
+	}
+
+	if ttl, err := store.TTL(chat.Key("step")); err != nil || ttl <= 0 || ttl > time.Hour {
+		t.Errorf("ttl is %s with error %v, want the session ttl to be applied", ttl, err)
+	}
+
+	if err := chat.Delete("step"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chat.Get("step", &step); err != ErrSessionNotFound {
+		t.Errorf("getting a deleted value returned %v, want %v", err, ErrSessionNotFound)
+	}
+}
@@ -0,0 +1,74 @@
+package session
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrSessionNotFound is returned when the requested key doesn't exist in the store or has expired
+var ErrSessionNotFound = errors.New("session not found")
+
+// ISessionStore is an interface that defines a key value storage for the session data
+/* Values are stored in JSON format, so they should be JSON serializable */
+type ISessionStore interface {
+	Get(key string, value interface{}) error                    // Decodes the stored value into value
+	Set(key string, value interface{}, ttl time.Duration) error // Zero ttl means the value never expires
+	Delete(key string) error
+	TTL(key string) (time.Duration, error) // Returns the remaining time to live, zero if it never expires
+}
+
+// Session is a type that gives access to the values of a single session, like the session of a chat or a user
+type Session struct {
+	store  ISessionStore
+	prefix string
+	ttl    time.Duration
+}
+
+// NewSession is a function that returns a new session whose values are stored using the prefix
+/* The ttl is applied every time a value is set */
+func NewSession(store ISessionStore, prefix string, ttl time.Duration) *Session {
+	return &Session{store: store, prefix: prefix, ttl: ttl}
+}
+
+// Get is a method that decodes the session value stored with the name into value
+func (session *Session) Get(name string, value interface{}) error {
+	return session.store.Get(session.Key(name), value)
+}
+
+// Set is a method that stores the value in the session with the name
+func (session *Session) Set(name string, value interface{}) error {
+	return session.store.Set(session.Key(name), value, session.ttl)
+}
+
+// Delete is a method that removes the session value stored with the name
+func (session *Session) Delete(name string) error {
+	return session.store.Delete(session.Key(name))
+}
+
+// Key is a method that returns the store key used for the session value with the name
+func (session *Session) Key(name string) string {
+	return session.prefix + ":" + name
+}
+
+// expiration is a function that returns the expiration time for the ttl, zero if the ttl is zero
+func expiration(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(ttl)
+}
+
+// remaining is a function that returns the time left before the expiration, zero if there is no expiration
+func remaining(expiresAt time.Time) time.Duration {
+	if expiresAt.IsZero() {
+		return 0
+	}
+
+	return time.Until(expiresAt)
+}
+
+// expired is a function that checks if the expiration time has passed
+func expired(expiresAt time.Time) bool {
+	return !expiresAt.IsZero() && !time.Now().Before(expiresAt)
+}